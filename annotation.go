@@ -0,0 +1,105 @@
+package core
+
+import (
+	"strings"
+)
+
+const (
+	annotationType      = "@type"
+	annotationName      = "@name"
+	annotationOmitEmpty = "@omitempty"
+	annotationSkip      = "@skip"
+	annotationMap       = "@map"
+)
+
+// 注释中的注解，例如 // @type time.Time，注解所在的行不会生成注释，Config的规则也会转换为注解
+type annotation struct {
+	// 属性的类型，原样输出
+	t string
+	// 属性名
+	name string
+	// 添加omitempty
+	omitEmpty bool
+	// 忽略这个属性
+	skip bool
+	// 对象生成map
+	mapFlag bool
+	// tag的选项，只能通过规则指定
+	tagOptions map[string]string
+}
+
+// 解析注释中的注解，返回注解和去掉注解后的注释
+// 一行中的所有内容都是注解时才作为注解，例如 // @name OrderID @omitempty
+func parseAnnotation(comment string) (annotation, string) {
+	var a annotation
+	if !strings.Contains(comment, "@") {
+		return a, comment
+	}
+	var lines []string
+	for _, line := range strings.Split(comment, "\n") {
+		if !parseAnnotationLine(line, &a) {
+			lines = append(lines, line)
+		}
+	}
+	return a, strings.Join(lines, "\n")
+}
+
+func parseAnnotationLine(line string, a *annotation) bool {
+	text := strings.TrimSpace(line)
+	if strings.HasPrefix(text, "//") {
+		text = strings.TrimPrefix(text, "//")
+	} else if strings.HasPrefix(text, "/*") && strings.HasSuffix(text, "*/") {
+		text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+	} else {
+		return false
+	}
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return false
+	}
+	b := *a
+	for i := 0; i < len(fields); i++ {
+		switch fields[i] {
+		case annotationType, annotationName:
+			if i+1 >= len(fields) {
+				return false
+			}
+			if fields[i] == annotationType {
+				b.t = fields[i+1]
+			} else {
+				b.name = fields[i+1]
+			}
+			i++
+		case annotationOmitEmpty:
+			b.omitEmpty = true
+		case annotationSkip:
+			b.skip = true
+		case annotationMap:
+			b.mapFlag = true
+		default:
+			return false
+		}
+	}
+	*a = b
+	return true
+}
+
+// 合并多个样本的注解
+func mergeAnnotation(nodes []*Node) annotation {
+	var a annotation
+	for _, node := range nodes {
+		if a.t == "" {
+			a.t = node.hint.t
+		}
+		if a.name == "" {
+			a.name = node.hint.name
+		}
+		a.omitEmpty = a.omitEmpty || node.hint.omitEmpty
+		a.skip = a.skip || node.hint.skip
+		a.mapFlag = a.mapFlag || node.hint.mapFlag
+		if a.tagOptions == nil {
+			a.tagOptions = node.hint.tagOptions
+		}
+	}
+	return a
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"json-to-go"
+	"os"
+	"strings"
+)
+
+// 命令行工具，从文件或标准输入读取json，生成go结构体
+// 用法：json-to-go [flags] [file]，不指定文件或者文件为"-"时，从标准输入读取
+func main() {
+	tags := flag.String("tags", "", "额外生成的tag，多个以英文逗号隔开，json tag默认生成")
+	tagOptions := flag.String("tag-options", "", "tag的选项，多个tag以英文分号隔开，例如 json:omitempty;bson:omitempty,inline")
+	omitEmptyFlag := flag.Bool("omitempty", false, "属性在部分样本中缺失或者为null时，自动添加omitempty")
+	comment := flag.Int("comment", core.Comment0, "0忽略注释，1生成单行注释 2生成行尾注释")
+	pointerFlag := flag.Bool("pointer", false, "是否使用指针")
+	autoPointerFlag := flag.Bool("auto-pointer", false, "只对在部分样本中缺失或者为null的属性使用指针，包括基础类型")
+	nestFlag := flag.Bool("nest", false, "是否嵌套结构")
+	timeFlag := flag.Bool("time", false, "是否识别时间，时间戳和日期生成包装类型")
+	int64Flag := flag.Bool("int64", false, "整数统一使用int64")
+	bigIntFlag := flag.Bool("bigint", false, "超出uint64范围的整数使用*big.Int，默认使用json.Number")
+	mapFlag := flag.Bool("map", false, "是否识别map，对象的key都是动态的并且值的类型相同时，生成map[string]T")
+	mapPaths := flag.String("map-paths", "", "强制生成map的路径，多个以英文逗号隔开，例如 $.data.users")
+	dedupFlag := flag.Bool("dedup", false, "结构相同的结构体只生成一个")
+	initialisms := flag.String("initialisms", "", "额外的缩写词，多个以英文逗号隔开，例如 SKU,OAuth")
+	excludeInitialisms := flag.String("exclude-initialisms", "", "不作为缩写词的单词，多个以英文逗号隔开，例如 ID")
+	fullPinYinFlag := flag.Bool("full-pinyin", false, "中文使用完整拼音，默认使用拼音首字母")
+	glossary := flag.String("glossary", "", "术语表文件，每行一个 原文=英文，例如 订单号=OrderNo")
+	enumFlag := flag.Bool("enum", false, "是否识别枚举，字符串属性不同的值较少时，生成枚举类型和常量")
+	enumMaxValues := flag.Int("enum-max", 0, "枚举值数量的上限，为0时使用10")
+	enumMinSamples := flag.Int("enum-min", 0, "识别为枚举需要的样本数量，为0时使用3")
+	enumValidFlag := flag.Bool("enum-valid", false, "枚举类型生成Valid方法")
+	unionFlag := flag.Bool("union", false, "属性在不同的样本中类型不同时，生成联合类型")
+	discriminatorFlag := flag.Bool("discriminator", false, "数组中的对象根据区分字段的值有不同的属性时，生成接口和每种类型的结构体")
+	discriminatorKeys := flag.String("discriminator-keys", "", "区分字段的候选key，多个以英文逗号隔开，默认 type,kind,event,@type,__typename")
+	recursiveFlag := flag.Bool("recursive", false, "是否识别树形结构，子对象和父对象的结构相同时生成引用自己的结构体")
+	envelopeFlag := flag.Bool("envelope", false, "是否识别通用的包装结构，生成泛型类型，例如 Response[T any] Page[T any]")
+	envelopes := flag.String("envelopes", "", "包装结构的定义文件，json数组，例如 [{\"name\": \"Result\", \"payload\": \"result\", \"keys\": [\"status\"]}]")
+	rules := flag.String("rules", "", "规则文件，json数组，根据路径修改属性，例如 [{\"path\": \"$.items[*].price\", \"type\": \"float64\"}]")
+	namer := flag.String("namer", core.NamerDefault, "命名策略，default 转换为驼峰式命名，original 保留key原来的大小写")
+	packageName := flag.String("pkg", "", "包名，不为空时生成完整的go文件")
+	fileHeader := flag.String("header", "", "文件头注释，只在生成完整的go文件时使用")
+	rootName := flag.String("root", core.DefaultName, "根结构体名称")
+	typePrefix := flag.String("prefix", "", "结构体名称前缀，根结构体除外")
+	typeSuffix := flag.String("suffix", "", "结构体名称后缀，根结构体除外")
+	output := flag.String("o", "", "输出文件，默认输出到标准输出")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "Usage: json-to-go [flags] [file]\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	jsonStr, err := readInput(flag.Arg(0))
+	if err != nil {
+		exit(err)
+	}
+	namerValue, ok := core.GetNamer(*namer)
+	if !ok {
+		exit(fmt.Errorf("unknown namer %q", *namer))
+	}
+	var glossaryMap map[string]string
+	if *glossary != "" {
+		data, err := os.ReadFile(*glossary)
+		if err != nil {
+			exit(err)
+		}
+		glossaryMap = core.ParseGlossary(string(data))
+	}
+	var ruleList []core.Rule
+	if *rules != "" {
+		data, err := os.ReadFile(*rules)
+		if err != nil {
+			exit(err)
+		}
+		if ruleList, err = core.ParseRules(string(data)); err != nil {
+			exit(err)
+		}
+	}
+	var envelopeList []core.Envelope
+	if *envelopes != "" {
+		data, err := os.ReadFile(*envelopes)
+		if err != nil {
+			exit(err)
+		}
+		if envelopeList, err = core.ParseEnvelopes(string(data)); err != nil {
+			exit(err)
+		}
+	}
+	config := core.Config{
+		Tags:               splitList(*tags),
+		TagOptions:         core.ParseTagOptions(*tagOptions),
+		OmitEmptyFlag:      *omitEmptyFlag,
+		Comment:            *comment,
+		PointerFlag:        *pointerFlag,
+		AutoPointerFlag:    *autoPointerFlag,
+		NestFlag:           *nestFlag,
+		PackageName:        *packageName,
+		FileHeader:         *fileHeader,
+		RootName:           *rootName,
+		TypePrefix:         *typePrefix,
+		TypeSuffix:         *typeSuffix,
+		TimeFlag:           *timeFlag,
+		Int64Flag:          *int64Flag,
+		BigIntFlag:         *bigIntFlag,
+		MapFlag:            *mapFlag,
+		MapPaths:           splitList(*mapPaths),
+		FullPinYinFlag:     *fullPinYinFlag,
+		Glossary:           glossaryMap,
+		EnumFlag:           *enumFlag,
+		EnumMaxValues:      *enumMaxValues,
+		EnumMinSamples:     *enumMinSamples,
+		EnumValidFlag:      *enumValidFlag,
+		UnionFlag:          *unionFlag,
+		DiscriminatorFlag:  *discriminatorFlag,
+		DiscriminatorKeys:  splitList(*discriminatorKeys),
+		RecursiveFlag:      *recursiveFlag,
+		EnvelopeFlag:       *envelopeFlag,
+		Envelopes:          envelopeList,
+		Rules:              ruleList,
+		Namer:              namerValue,
+		DedupFlag:          *dedupFlag,
+		Initialisms:        splitList(*initialisms),
+		ExcludeInitialisms: splitList(*excludeInitialisms),
+	}
+	generate, err := core.Generate(jsonStr, &config)
+	if err != nil {
+		exit(err)
+	}
+	if err = writeOutput(*output, generate); err != nil {
+		exit(err)
+	}
+}
+
+// 读取输入，文件为空或者"-"时读取标准输入
+func readInput(file string) (string, error) {
+	var data []byte
+	var err error
+	if file == "" || file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func writeOutput(file string, generate string) error {
+	if !strings.HasSuffix(generate, "\n") {
+		generate += "\n"
+	}
+	if file == "" {
+		_, err := io.WriteString(os.Stdout, generate)
+		return err
+	}
+	return os.WriteFile(file, []byte(generate), 0644)
+}
+
+func splitList(tags string) []string {
+	var result []string
+	for _, t := range strings.Split(tags, ",") {
+		t = strings.TrimSpace(t)
+		if t != "" {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func exit(err error) {
+	_, _ = fmt.Fprintln(os.Stderr, "json-to-go:", err)
+	os.Exit(1)
+}
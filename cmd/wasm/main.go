@@ -32,6 +32,10 @@ func JsonToGoGen(this js.Value, args []js.Value) interface{} {
 		}
 	}
 	config.Tags = tags
+	config.TagOptions = core.ParseTagOptions(getStringVue(jsonValue, "tagOptions"))
+	if getStringVue(jsonValue, "omitEmptyFlag") == "true" {
+		config.OmitEmptyFlag = true
+	}
 	commentStr := getStringVue(jsonValue, "comment")
 	comment, _ := strconv.Atoi(commentStr)
 	config.Comment = comment
@@ -39,10 +43,92 @@ func JsonToGoGen(this js.Value, args []js.Value) interface{} {
 	if pointerFlag == "true" {
 		config.PointerFlag = true
 	}
+	if getStringVue(jsonValue, "autoPointerFlag") == "true" {
+		config.AutoPointerFlag = true
+	}
 	nestFlag := getStringVue(jsonValue, "nestFlag")
 	if nestFlag == "true" {
 		config.NestFlag = true
 	}
+	if getStringVue(jsonValue, "timeFlag") == "true" {
+		config.TimeFlag = true
+	}
+	if getStringVue(jsonValue, "int64Flag") == "true" {
+		config.Int64Flag = true
+	}
+	if getStringVue(jsonValue, "bigIntFlag") == "true" {
+		config.BigIntFlag = true
+	}
+	if getStringVue(jsonValue, "mapFlag") == "true" {
+		config.MapFlag = true
+	}
+	if mapPaths := getStringVue(jsonValue, "mapPaths"); mapPaths != "" {
+		config.MapPaths = strings.Split(mapPaths, ",")
+	}
+	if getStringVue(jsonValue, "dedupFlag") == "true" {
+		config.DedupFlag = true
+	}
+	if initialisms := getStringVue(jsonValue, "initialisms"); initialisms != "" {
+		config.Initialisms = strings.Split(initialisms, ",")
+	}
+	if excludeInitialisms := getStringVue(jsonValue, "excludeInitialisms"); excludeInitialisms != "" {
+		config.ExcludeInitialisms = strings.Split(excludeInitialisms, ",")
+	}
+	if getStringVue(jsonValue, "fullPinYinFlag") == "true" {
+		config.FullPinYinFlag = true
+	}
+	if getStringVue(jsonValue, "enumFlag") == "true" {
+		config.EnumFlag = true
+	}
+	config.EnumMaxValues, _ = strconv.Atoi(getStringVue(jsonValue, "enumMaxValues"))
+	config.EnumMinSamples, _ = strconv.Atoi(getStringVue(jsonValue, "enumMinSamples"))
+	if getStringVue(jsonValue, "enumValidFlag") == "true" {
+		config.EnumValidFlag = true
+	}
+	if getStringVue(jsonValue, "unionFlag") == "true" {
+		config.UnionFlag = true
+	}
+	if getStringVue(jsonValue, "discriminatorFlag") == "true" {
+		config.DiscriminatorFlag = true
+	}
+	if discriminatorKeys := getStringVue(jsonValue, "discriminatorKeys"); discriminatorKeys != "" {
+		config.DiscriminatorKeys = strings.Split(discriminatorKeys, ",")
+	}
+	if getStringVue(jsonValue, "recursiveFlag") == "true" {
+		config.RecursiveFlag = true
+	}
+	if getStringVue(jsonValue, "envelopeFlag") == "true" {
+		config.EnvelopeFlag = true
+	}
+	config.Glossary = core.ParseGlossary(getStringVue(jsonValue, "glossary"))
+	if rules := getStringVue(jsonValue, "rules"); rules != "" {
+		r, err := core.ParseRules(rules)
+		if err != nil {
+			return map[string]interface{}{
+				"code":    500,
+				"message": err.Error(),
+			}
+		}
+		config.Rules = r
+	}
+	if envelopes := getStringVue(jsonValue, "envelopes"); envelopes != "" {
+		e, err := core.ParseEnvelopes(envelopes)
+		if err != nil {
+			return map[string]interface{}{
+				"code":    500,
+				"message": err.Error(),
+			}
+		}
+		config.Envelopes = e
+	}
+	if namer, ok := core.GetNamer(getStringVue(jsonValue, "namer")); ok {
+		config.Namer = namer
+	}
+	config.PackageName = getStringVue(jsonValue, "packageName")
+	config.FileHeader = getStringVue(jsonValue, "fileHeader")
+	config.RootName = getStringVue(jsonValue, "rootName")
+	config.TypePrefix = getStringVue(jsonValue, "typePrefix")
+	config.TypeSuffix = getStringVue(jsonValue, "typeSuffix")
 	generate, err := core.Generate(jsonStr, &config)
 	if err != nil {
 		return map[string]interface{}{
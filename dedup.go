@@ -0,0 +1,148 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 合并结构相同的结构体，all[0]是根结构体，不参与合并
+// 属性完全相同时，使用第一个结构体；属性是其他结构体的子集时，使用属性最多的结构体
+func dedupNode(all []*Node, config *Config) {
+	if len(all) < 2 {
+		return
+	}
+	signs := make(map[*Node]map[string]string)
+	for _, a := range all {
+		signs[a] = fieldSigns(a, config)
+	}
+	// 签名相同，使用第一个结构体
+	first := make(map[string]*Node)
+	for i, a := range all {
+		sign := nodeSign(a, config)
+		if a.discriminator != "" {
+			// 多态类型只和多态类型合并
+			sign = a.discriminator + " " + sign
+		}
+		if f, ok := first[sign]; ok && i > 0 {
+			a.ref = f
+		} else if !ok {
+			first[sign] = a
+		}
+	}
+	// 属性多的结构体优先，属性数量相同时保持原来的顺序
+	array := make([]*Node, 0)
+	for _, a := range all[1:] {
+		if a.ref == nil && a.discriminator == "" {
+			array = append(array, a)
+		}
+	}
+	sort.SliceStable(array, func(i, j int) bool {
+		return len(signs[array[i]]) > len(signs[array[j]])
+	})
+	for i, a := range array {
+		// 多态类型的分支只能完全相同时合并，否则可能有多个分支合并到同一个结构体
+		if len(signs[a]) == 0 || isPolymorphicVariant(a) {
+			continue
+		}
+		for _, b := range array[:i] {
+			if b.ref != nil || len(signs[b]) == len(signs[a]) || len(signs[a])*2 < len(signs[b]) {
+				continue
+			}
+			// 不能引用自己的祖先，否则会生成无限递归的结构体
+			if isSubset(signs[a], signs[b]) && !containsNode(b, a) {
+				a.ref = b
+				break
+			}
+		}
+	}
+	for _, a := range all {
+		if a.ref != nil {
+			a.ref = refNode(a.ref)
+		}
+	}
+}
+
+// 结构体对应的最终结构体
+func refNode(node *Node) *Node {
+	for node.ref != nil {
+		node = node.ref
+	}
+	return node
+}
+
+// 结构体的签名，由属性名和属性类型组成，和属性的顺序无关
+func nodeSign(node *Node, config *Config) string {
+	signs := fieldSigns(node, config)
+	array := make([]string, 0, len(signs))
+	for k, v := range signs {
+		array = append(array, k+":"+v)
+	}
+	sort.Strings(array)
+	return "{" + strings.Join(array, ",") + "}"
+}
+
+// 所有属性的签名，属性名；属性类型
+func fieldSigns(node *Node, config *Config) map[string]string {
+	signs := make(map[string]string)
+	for _, n := range *node.children {
+		signs[n.k] = fieldSign(n, config)
+	}
+	return signs
+}
+
+func fieldSign(node *Node, config *Config) string {
+	sign := node.g + " " + node.t
+	if isObject(node.g) {
+		sign += " " + nodeSign(node, config)
+	} else if groupBase(node.g) == GroupM && len(*node.children) > 0 {
+		sign += " " + fieldSign((*node.children)[0], config)
+	}
+	// 影响omitempty和指针的生成
+	if node.optional && (config.OmitEmptyFlag || config.AutoPointerFlag) {
+		sign += " optional"
+	}
+	if node.union {
+		for _, n := range *node.children {
+			sign += " union:" + fieldSign(n, config)
+		}
+	}
+	if isSelfRef(node) {
+		sign += " recursive"
+	}
+	if node.discriminator != "" {
+		sign += " discriminator:" + node.discriminator + " " + nodeSign(node, config)
+	}
+	if node.enum {
+		sign += " enum:" + strings.Join(distinctValues(node.values), ",")
+	}
+	if node.hint.name != "" {
+		sign += " name:" + node.hint.name
+	}
+	if node.hint.omitEmpty {
+		sign += " omitempty"
+	}
+	if len(node.hint.tagOptions) > 0 {
+		sign += " " + fmt.Sprint(node.hint.tagOptions)
+	}
+	return sign
+}
+
+func isSubset(a map[string]string, b map[string]string) bool {
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// 判断node是否是parent的子孙节点
+func containsNode(parent *Node, node *Node) bool {
+	for _, n := range *parent.children {
+		if n == node || containsNode(n, node) {
+			return true
+		}
+	}
+	return false
+}
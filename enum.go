@@ -0,0 +1,124 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"json-to-go/jsonparser"
+	"strconv"
+	"strings"
+)
+
+const (
+	// 枚举值数量的默认上限
+	defaultEnumMaxValues = 10
+	// 识别为枚举需要的默认样本数量
+	defaultEnumMinSamples = 3
+)
+
+// 收集字符串的值，数组收集所有元素的值，用来识别枚举
+func collectStrings(value []byte, dataType jsonparser.ValueType, depth int) []string {
+	if depth == 0 {
+		if dataType != jsonparser.String {
+			return nil
+		}
+		s, err := jsonparser.Unescape(value, nil)
+		if err != nil {
+			return nil
+		}
+		return []string{string(s)}
+	}
+	var result []string
+	_ = jsonparser.ArrayEach(value, func(value2 []byte, dataType2 jsonparser.ValueType, offset2 int, comment []byte) (bool, error) {
+		result = append(result, collectStrings(value2, dataType2, depth-1)...)
+		return true, nil
+	})
+	return result
+}
+
+// 识别枚举，字符串属性的样本数量足够，并且不同的值较少时生成枚举
+func recursionEnum(node *Node, config *Config) {
+	node.enum = isEnum(node, config)
+	for _, n := range *node.children {
+		recursionEnum(n, config)
+	}
+}
+
+func isEnum(node *Node, config *Config) bool {
+	if groupBase(node.g) != GroupV || node.t != TypeString || node.hint.t != "" {
+		return false
+	}
+	maxValues := config.EnumMaxValues
+	if maxValues <= 0 {
+		maxValues = defaultEnumMaxValues
+	}
+	minSamples := config.EnumMinSamples
+	if minSamples <= 0 {
+		minSamples = defaultEnumMinSamples
+	}
+	if len(node.values) < minSamples {
+		return false
+	}
+	values := distinctValues(node.values)
+	// 每个值只出现一次时不是枚举
+	if len(values) > maxValues || len(values) == len(node.values) {
+		return false
+	}
+	for _, v := range values {
+		if v == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// 去重，保持第一次出现的顺序
+func distinctValues(values []string) []string {
+	var result []string
+	exist := make(map[string]bool)
+	for _, v := range values {
+		if !exist[v] {
+			exist[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// 收集所有的枚举，合并掉的结构体除外
+func collectEnums(node *Node, enums *[]*Node) {
+	if node.ref != nil {
+		return
+	}
+	if node.enum {
+		*enums = append(*enums, node)
+	}
+	for _, n := range *node.children {
+		collectEnums(n, enums)
+	}
+}
+
+// 生成枚举的类型和常量，可选生成Valid方法
+// 常量名和类型名在同一个命名空间，和已经使用的名称重名时末尾加数字
+func writeEnumTypes(buff *bytes.Buffer, enums []*Node, used map[string]bool, config *Config) {
+	for _, e := range enums {
+		var names []string
+		// 常量名在枚举内不重复
+		nameMap := make(map[string]string)
+		nameCount := make(map[string]int)
+		buff.WriteString(fmt.Sprintf("\n\ntype %s string\n\nconst (\n", e.name))
+		for _, v := range distinctValues(e.values) {
+			base := e.name + formatKey(nameMap, nameCount, v, config)
+			name := base
+			for i := 1; used[name]; i++ {
+				name = base + strconv.Itoa(i)
+			}
+			used[name] = true
+			names = append(names, name)
+			buff.WriteString(fmt.Sprintf("%s %s = %s\n", name, e.name, strconv.Quote(v)))
+		}
+		buff.WriteString(")")
+		if config.EnumValidFlag {
+			buff.WriteString(fmt.Sprintf("\n\n// Valid 是否是已知的值\nfunc (e %s) Valid() bool {\nswitch e {\ncase %s:\nreturn true\n}\nreturn false\n}", e.name, strings.Join(names, ", ")))
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Envelope 通用的包装结构，例如 {"code": 0, "msg": "", "data": {}}，生成泛型类型 Response[T any]，T是data的类型
+type Envelope struct {
+	// 泛型类型的名称，例如 Response
+	Name string `json:"name"`
+	// 数据的key，类型是T
+	Payload string `json:"payload"`
+	// 必须有的key
+	Keys []string `json:"keys,omitempty"`
+	// 可选的key，对象的key只能是这些key
+	Optional []string `json:"optional,omitempty"`
+}
+
+// 默认的包装结构
+var defaultEnvelopes = []Envelope{
+	{Name: "Response", Payload: "data", Keys: []string{"code"}, Optional: []string{"msg", "message", "success", "traceId", "requestId"}},
+	{Name: "Page", Payload: "items", Keys: []string{"total"}, Optional: []string{"page", "size", "pageSize", "page_size", "pageNum", "page_num", "pages", "hasMore", "has_more"}},
+	{Name: "Page", Payload: "list", Keys: []string{"total"}, Optional: []string{"page", "size", "pageSize", "page_size", "pageNum", "page_num", "pages", "hasMore", "has_more"}},
+	{Name: "Page", Payload: "records", Keys: []string{"total"}, Optional: []string{"page", "size", "pageSize", "page_size", "pageNum", "page_num", "pages", "hasMore", "has_more"}},
+}
+
+// ParseEnvelopes 解析包装结构的定义，内容是json数组，例如 [{"name": "Result", "payload": "result", "keys": ["status"]}]
+func ParseEnvelopes(str string) ([]Envelope, error) {
+	var envelopes []Envelope
+	if err := json.Unmarshal([]byte(str), &envelopes); err != nil {
+		return nil, err
+	}
+	return envelopes, nil
+}
+
+// 生成的泛型类型
+type envelopeDecl struct {
+	Envelope
+	// 第一个匹配的对象，使用它的属性生成泛型类型
+	node *Node
+	// 除数据外的属性的签名，同名的包装结构签名必须相同
+	sign string
+	// 数据的数组维度，维度为0时T可以是数组
+	depth int
+}
+
+// 识别包装结构，返回所有包装结构的对象和需要生成的泛型类型，合并掉的结构体除外
+// 根节点是数组、树形结构体、多态类型和联合类型的分支不识别
+func collectEnvelopes(node *Node, nodes *[]*Node, decls *[]*envelopeDecl, config *Config) {
+	if node.ref != nil {
+		return
+	}
+	if isObject(node.g) && !isRootElem(node) && !node.recursive && !isPolymorphicVariant(node) && !(node.parent != nil && node.parent.union) {
+		for _, e := range configEnvelopes(config) {
+			if !matchEnvelope(node, e) {
+				continue
+			}
+			sign := envelopeSign(node, e.Payload, config)
+			depth := groupDepth(payloadNode(node, e.Payload).g)
+			var decl *envelopeDecl
+			for _, d := range *decls {
+				if d.Name == e.Name {
+					decl = d
+				}
+			}
+			if decl == nil {
+				decl = &envelopeDecl{Envelope: e, node: node, sign: sign, depth: depth}
+				*decls = append(*decls, decl)
+			} else if decl.Payload != e.Payload || decl.sign != sign || decl.depth > 0 && decl.depth != depth {
+				continue
+			}
+			node.envelope = decl
+			*nodes = append(*nodes, node)
+			break
+		}
+	}
+	for _, n := range *node.children {
+		collectEnvelopes(n, nodes, decls, config)
+	}
+}
+
+// 是否是根节点是数组或者map时的元素，元素的名称已经确定
+func isRootElem(node *Node) bool {
+	root := node
+	for root.parent != nil {
+		root = root.parent
+	}
+	return root.g != GroupO && structNode(root) == node
+}
+
+// 配置的包装结构，没有配置时使用默认的
+func configEnvelopes(config *Config) []Envelope {
+	if len(config.Envelopes) == 0 {
+		return defaultEnvelopes
+	}
+	return config.Envelopes
+}
+
+func matchEnvelope(node *Node, e Envelope) bool {
+	var keys []string
+	for _, n := range *node.children {
+		keys = append(keys, n.k)
+	}
+	return matchEnvelopeKeys(keys, e)
+}
+
+// 有数据和所有必须的key，并且没有其他的key
+func matchEnvelopeKeys(list []string, e Envelope) bool {
+	allowed := map[string]bool{e.Payload: true}
+	for _, k := range append(e.Keys, e.Optional...) {
+		allowed[k] = true
+	}
+	keys := make(map[string]bool)
+	for _, k := range list {
+		if !allowed[k] {
+			return false
+		}
+		keys[k] = true
+	}
+	if !keys[e.Payload] {
+		return false
+	}
+	for _, k := range e.Keys {
+		if !keys[k] {
+			return false
+		}
+	}
+	return true
+}
+
+func envelopeSign(node *Node, payload string, config *Config) string {
+	var signs []string
+	for _, n := range *node.children {
+		if n.k == payload {
+			// 数据的类型不影响
+			signs = append(signs, fmt.Sprint(n.k, " payload ", n.optional && (config.OmitEmptyFlag || config.AutoPointerFlag), n.hint.name, n.hint.omitEmpty, n.hint.tagOptions))
+		} else {
+			signs = append(signs, n.k+":"+fieldSign(n, config))
+		}
+	}
+	return strings.Join(signs, ",")
+}
+
+// 包装结构的数据
+func payloadNode(node *Node, payload string) *Node {
+	for _, n := range *node.children {
+		if n.k == payload {
+			return n
+		}
+	}
+	return nil
+}
+
+// 包装结构的类型，例如 Response[Data] Page[Items]，泛型类型中的数组去掉
+func envelopeTypeName(node *Node, config *Config) string {
+	payload := payloadNode(node, node.envelope.Payload)
+	arg := nodeType(payload.k, payload, config)
+	for i := 0; i < node.envelope.depth; i++ {
+		arg = strings.TrimPrefix(arg, groupArray)
+	}
+	return node.envelope.Name + "[" + arg + "]"
+}
+
+// 属性的完整类型，嵌套结构体时使用匿名结构体
+func nodeType(key string, node *Node, config *Config) string {
+	typeName := key
+	if config.NestFlag {
+		typeName = formatNestKey(key, node, config)
+	} else if needTypeName(node) {
+		typeName = refNode(structNode(node)).name
+	}
+	return formatNodeType(typeName, node, config)
+}
+
+// 生成泛型类型的声明，数据的类型是T
+func envelopeDecls(decls []*envelopeDecl, config *Config) []string {
+	var result []string
+	for _, d := range decls {
+		var buff bytes.Buffer
+		buff.WriteString(fmt.Sprintf("// %s 通用的包装结构，T是%s的类型\ntype %s[T any] struct {\n", d.Name, d.Payload, d.Name))
+		nameMap := make(map[string]string)
+		nameCount := make(map[string]int)
+		for _, node := range *d.node.children {
+			if node.c != "" && config.Comment == Comment1 {
+				buff.WriteString(node.c + "\n")
+			}
+			key := fieldName(nameMap, nameCount, node, config)
+			t := "T"
+			if node.k == d.Payload {
+				t = strings.Repeat(groupArray, d.depth) + t
+			} else {
+				t = nodeType(key, node, config)
+			}
+			if node.c != "" && config.Comment == Comment2 {
+				buff.WriteString(fmt.Sprintf("%s %s %s %s\n", key, t, nodeTag(node, config), node.c))
+			} else {
+				buff.WriteString(fmt.Sprintf("%s %s %s\n", key, t, nodeTag(node, config)))
+			}
+		}
+		buff.WriteString("}")
+		result = append(result, buff.String())
+	}
+	return result
+}
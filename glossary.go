@@ -0,0 +1,96 @@
+package core
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ParseGlossary 解析术语表，每行一个 原文=英文，空行和#开头的行忽略，例如 订单号=OrderNo
+func ParseGlossary(str string) map[string]string {
+	result := make(map[string]string)
+	for _, line := range strings.Split(str, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		split := strings.SplitN(line, "=", 2)
+		if len(split) != 2 || strings.TrimSpace(split[0]) == "" || strings.TrimSpace(split[1]) == "" {
+			continue
+		}
+		result[strings.TrimSpace(split[0])] = strings.TrimSpace(split[1])
+	}
+	return result
+}
+
+// 使用术语表替换key中的词语，优先匹配最长的，替换的英文前后使用下划线分割
+// 例如 用户订单号 替换为 用户_OrderNo，没有匹配的部分继续使用拼音
+// 英文的原文只匹配完整的单词（下划线和驼峰分割），例如 id 不匹配 paid
+func applyGlossary(key string, glossary map[string]string) string {
+	if len(glossary) == 0 {
+		return key
+	}
+	maxLength := 0
+	for k := range glossary {
+		if n := len([]rune(k)); n > maxLength {
+			maxLength = n
+		}
+	}
+	var buffer strings.Builder
+	runes := []rune(key)
+	for i := 0; i < len(runes); {
+		j := i + maxLength
+		if j > len(runes) {
+			j = len(runes)
+		}
+		for ; j > i; j-- {
+			e, ok := glossary[string(runes[i:j])]
+			if ok && isASCII(runes[i:j]) && !(isWordBoundary(runes, i) && isWordBoundary(runes, j)) {
+				ok = false
+			}
+			if ok {
+				if i > 0 && runes[i-1] != '_' {
+					buffer.WriteRune('_')
+				}
+				buffer.WriteString(e)
+				if j < len(runes) && runes[j] != '_' {
+					buffer.WriteRune('_')
+				}
+				break
+			}
+		}
+		if j == i {
+			buffer.WriteRune(runes[i])
+			j = i + 1
+		}
+		i = j
+	}
+	return buffer.String()
+}
+
+func isASCII(runes []rune) bool {
+	for _, r := range runes {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
+
+// 位置i是否是单词的边界，非字母数字、驼峰、字母和数字之间都是边界，例如 user_id userId userID IDName id2
+func isWordBoundary(runes []rune, i int) bool {
+	if i == 0 || i == len(runes) {
+		return true
+	}
+	a, b := runes[i-1], runes[i]
+	if !isASCIIAlnum(a) || !isASCIIAlnum(b) {
+		return true
+	}
+	if unicode.IsDigit(a) != unicode.IsDigit(b) || unicode.IsLower(a) && unicode.IsUpper(b) {
+		return true
+	}
+	return unicode.IsUpper(a) && unicode.IsUpper(b) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
+
+func isASCIIAlnum(r rune) bool {
+	return r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
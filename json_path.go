@@ -0,0 +1,107 @@
+package core
+
+import "strings"
+
+// 根节点的路径
+const rootPath = "$"
+
+// 拼接属性的路径，特殊字符的key使用 ['key'] 的形式
+func joinPath(path string, key string) string {
+	if key == "" || strings.ContainsAny(key, ".[]'\"* ") {
+		return path + "['" + strings.ReplaceAll(key, "'", "\\'") + "']"
+	}
+	return path + "." + key
+}
+
+// 数组内元素的路径，例如 $.items[*]
+func elemPath(node *Node) string {
+	return node.p + strings.Repeat("[*]", groupDepth(node.g))
+}
+
+// 递归匹配任意层级
+const pathDescendant = ".."
+
+// 数组元素
+const pathElem = "[*]"
+
+// 任意属性
+const pathWildcard = "*"
+
+// 判断路径是否匹配，支持通配符
+// $.items[*].price 精确匹配；$.*.price 匹配任意属性；$.items[0] 和 $.items[*] 相同；$..price 匹配任意层级
+func matchPath(pattern string, path string) bool {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == path {
+		return true
+	}
+	return matchSegments(splitPath(pattern), splitPath(path))
+}
+
+func matchSegments(pattern []string, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+	if pattern[0] == pathDescendant {
+		for i := 0; i <= len(path); i++ {
+			if matchSegments(pattern[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(path) == 0 {
+		return false
+	}
+	if pattern[0] == path[0] || pattern[0] == pathWildcard && path[0] != pathElem {
+		return matchSegments(pattern[1:], path[1:])
+	}
+	return false
+}
+
+// 把路径分割为属性和数组元素，开头的$可以省略
+func splitPath(path string) []string {
+	var result []string
+	path = strings.TrimPrefix(path, rootPath)
+	for i := 0; i < len(path); {
+		switch {
+		case strings.HasPrefix(path[i:], pathDescendant):
+			result = append(result, pathDescendant)
+			i += len(pathDescendant)
+			if i < len(path) && path[i] != '[' {
+				// $..price，不需要再加.
+				path = path[:i] + "." + path[i:]
+			}
+		case path[i] == '.':
+			j := i + 1
+			for j < len(path) && path[j] != '.' && path[j] != '[' {
+				j++
+			}
+			result = append(result, path[i+1:j])
+			i = j
+		case strings.HasPrefix(path[i:], "['"):
+			// 特殊字符的key，例如 ['a.b']
+			var key strings.Builder
+			j := i + 2
+			for ; j < len(path) && !strings.HasPrefix(path[j:], "']"); j++ {
+				if path[j] == '\\' && j+1 < len(path) {
+					j++
+				}
+				key.WriteByte(path[j])
+			}
+			result = append(result, key.String())
+			i = j + 2
+		case path[i] == '[':
+			// 数组的下标都作为[*]
+			j := strings.IndexByte(path[i:], ']')
+			if j < 0 {
+				j = len(path) - i - 1
+			}
+			result = append(result, pathElem)
+			i += j + 1
+		default:
+			// 省略了开头的$.
+			path = path[:i] + "." + path[i:]
+		}
+	}
+	return result
+}
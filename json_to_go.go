@@ -2,35 +2,54 @@ package core
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
 	"json-to-go/jsonparser"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
 )
 
-// 大类型 group
+// 大类型 group，由数组维度和基础类型组成，例如 [][][]Value，支持任意维度
 const (
 	GroupV    = "Value"
 	GroupV1   = "[]Value"
 	GroupV2   = "[][]Value"
 	GroupO    = "Object"
+	GroupM    = "Map" // 动态key的对象，生成map[string]T
 	GroupO1   = "[]Object"
 	GroupO2   = "[][]Object"
-	GroupNil1 = "[]"   // 临时类型
+	GroupNil1 = "[]"   // 临时类型，空数组，没有基础类型
 	GroupNil2 = "[][]" // 临时类型
 )
 
+// 数组维度
+const groupArray = "[]"
+
 // 小类型 type
 const (
-	TypeString  = "string"
-	TypeBool    = "bool"
-	TypeFloat64 = "float64"
-	TypeInt     = "int"
-	TypeInt64   = "int64"
-	TypeAny     = "interface{}"
-	TypeNil     = "nil" // 临时类型，属性为null的，数组为空的，都先用这个表示。最后再进行属性合并的时候会用到
+	TypeString   = "string"
+	TypeBool     = "bool"
+	TypeFloat64  = "float64"
+	TypeInt      = "int"
+	TypeInt64    = "int64"
+	TypeUint64   = "uint64"
+	TypeNumber   = "json.Number" // 超出uint64范围的整数
+	TypeBigInt   = "*big.Int"    // 超出uint64范围的整数，需要开启BigIntFlag
+	TypeNegInt   = "-int"        // 临时类型，负整数，和uint64合并时使用
+	TypeNegInt64 = "-int64"      // 临时类型
+	TypeAny      = "interface{}"
+	TypeNil      = "nil" // 临时类型，属性为null的，数组为空的，都先用这个表示。最后再进行属性合并的时候会用到
+	// 时间类型，需要开启TimeFlag
+	TypeTime          = "time.Time"
+	TypeDate          = "Date"          // 日期，生成包装类型
+	TypeUnixTime      = "UnixTime"      // 秒级时间戳，生成包装类型
+	TypeUnixMilliTime = "UnixMilliTime" // 毫秒级时间戳，生成包装类型
 )
 
 const (
@@ -39,13 +58,46 @@ const (
 	Comment2
 )
 
+// ErrEmptyJSON json字符串为空
+var ErrEmptyJSON = errors.New("json is empty")
+
 const (
 	DefaultName = "AutoGenerated"
-	DefaultTag  = "json"
-	MaxInt32    = 1<<31 - 1
-	MinInt32    = -1 << 31
+	// 根节点是数组时，数组内对象的结构体名称后缀
+	rootElem   = "Elem"
+	DefaultTag = "json"
+	MaxInt32   = 1<<31 - 1
+	MinInt32   = -1 << 31
 )
 
+// 生成的代码中可能用到的包，包名；导入路径
+var knownImports = map[string]string{
+	"big":     "math/big",
+	"bytes":   "bytes",
+	"errors":  "errors",
+	"fmt":     "fmt",
+	"json":    "encoding/json",
+	"strconv": "strconv",
+	"strings": "strings",
+	"time":    "time",
+}
+
+// 属性名无法生成时的默认名称，后面加数字
+const defaultFieldName = "Field"
+
+// 结构体名称无法生成时的默认名称
+const defaultTypeName = "Type"
+
+// 符号的名称，只有符号的key使用
+var symbolNames = map[rune]string{
+	'@': "At", '$': "Dollar", '#': "Hash", '%': "Percent", '&': "And", '*': "Star",
+	'+': "Plus", '-': "Minus", '=': "Equal", '!': "Bang", '?': "Question", '.': "Dot",
+	'/': "Slash", '\\': "Backslash", '~': "Tilde", '^': "Caret", '|': "Pipe", ':': "Colon",
+	';': "Semicolon", '<': "Lt", '>': "Gt", '(': "LParen", ')': "RParen", '[': "LBracket",
+	']': "RBracket", '{': "LBrace", '}': "RBrace", '\'': "Quote", '"': "DoubleQuote",
+	'`': "Backtick", ',': "Comma", '_': "Underscore", ' ': "Space",
+}
+
 // https://github.com/golang/lint/blob/master/lint.go
 var commonInitialisms = map[string]struct{}{
 	"ACL":   {},
@@ -97,6 +149,66 @@ type Config struct {
 	PointerFlag bool
 	// 是否嵌套结构
 	NestFlag bool
+	// 包名，不为空时生成完整的go文件，包括package和import
+	PackageName string
+	// 文件头注释，只在生成完整的go文件时使用
+	FileHeader string
+	// 根结构体名称，为空时使用DefaultName
+	RootName string
+	// 结构体名称前缀，根结构体除外
+	TypePrefix string
+	// 结构体名称后缀，根结构体除外
+	TypeSuffix string
+	// tag的选项，tag名；选项，例如 json:omitempty bson:omitempty,inline
+	TagOptions map[string]string
+	// 属性在部分样本中缺失或者为null时，自动添加omitempty
+	OmitEmptyFlag bool
+	// 是否识别时间，所有样本都是时间格式时使用time.Time，时间戳和日期生成包装类型
+	TimeFlag bool
+	// 只对在部分样本中缺失或者为null的属性使用指针，包括基础类型
+	AutoPointerFlag bool
+	// 整数统一使用int64，默认根据数值大小使用int或者int64
+	Int64Flag bool
+	// 超出uint64范围的整数使用*big.Int，默认使用json.Number
+	BigIntFlag bool
+	// 是否识别map，对象的key都是动态的（数字、日期、uuid等）并且值的类型相同时，生成map[string]T
+	MapFlag bool
+	// 强制生成map的路径，例如 $.data.users
+	MapPaths []string
+	// 结构相同的结构体只生成一个，属性是其他结构体的子集时也会合并
+	DedupFlag bool
+	// 属性名和结构体名称的命名策略，为空时使用DefaultNamer
+	Namer Namer
+	// 额外的缩写词，支持大小写混合的写法，例如 SKU OAuth
+	Initialisms []string
+	// 不作为缩写词的单词，例如 ID 生成 Id
+	ExcludeInitialisms []string
+	// 中文使用完整拼音，例如 用户名称 生成 YongHuMingCheng，默认使用拼音首字母 Yhmc
+	FullPinYinFlag bool
+	// 术语表，原文；英文，在转拼音之前替换，例如 订单号 替换为 OrderNo，tag仍然使用原来的key
+	Glossary map[string]string
+	// 根据json路径修改属性的类型、属性名、tag选项，或者忽略属性
+	Rules []Rule
+	// 是否识别枚举，字符串属性不同的值较少时，生成枚举类型和常量
+	EnumFlag bool
+	// 枚举值数量的上限，为0时使用10
+	EnumMaxValues int
+	// 识别为枚举需要的样本数量，为0时使用3
+	EnumMinSamples int
+	// 枚举类型生成Valid方法
+	EnumValidFlag bool
+	// 属性在不同的样本中类型不同时，生成联合类型，例如 StringOrInt ItemOrItems，默认使用interface{}
+	UnionFlag bool
+	// 数组中的对象根据区分字段的值有不同的属性时，生成接口、每种类型的结构体和根据区分字段解析的包装类型
+	DiscriminatorFlag bool
+	// 区分字段的候选key，按顺序匹配，为空时使用 type kind event @type __typename
+	DiscriminatorKeys []string
+	// 识别树形结构，子对象和父对象的结构相同时合并所有层级，生成引用自己的结构体，例如 Children []*Menu
+	RecursiveFlag bool
+	// 识别通用的包装结构，生成泛型类型，例如 Response[T any] Page[T any]，数据的类型单独命名
+	EnvelopeFlag bool
+	// 包装结构的定义，为空时使用默认的定义，识别 code msg data 和 items total page 等结构
+	Envelopes []Envelope
 }
 
 type Node struct {
@@ -108,80 +220,353 @@ type Node struct {
 	g string
 	// 注释
 	c string
+	// json路径，例如 $.items[*].price
+	p string
+	// 对象的样本数量，用来判断属性是否在部分样本中缺失
+	count int
+	// 属性在部分样本中缺失或者为null
+	optional bool
 	// 嵌套结构
 	children *[]*Node
 	// 用来merge的
 	childrenMerge *[][]*Node
 	// childrenMerge 下标使用
 	cache map[string]int
+	// 结构相同的结构体，不为空时使用它的结构体名称
+	ref *Node
+	// 父节点
+	parent *Node
+	// 结构体名称
+	name string
+	// 注释中的注解
+	hint annotation
+	// 字符串属性的所有值，用来识别枚举
+	values []string
+	// 是否是枚举
+	enum bool
+	// 是否是联合类型，子节点是每个分支
+	union bool
+	// 多态类型的区分字段，子节点是每种类型，values是对应的区分字段的值
+	discriminator string
+	// 每个对象样本，用来识别区分字段
+	samples []*Node
+	// 是否是树形结构体，自引用的属性ref指向这个节点
+	recursive bool
+	// 包装结构，不为空时使用泛型类型
+	envelope *envelopeDecl
+	// 根节点是数组、map或者基础类型时的虚拟对象
+	virtual bool
 }
 
 // Generate json字符串转对象，在前端进行了json5格式验证和格式化
 func Generate(jsonStr string, config *Config) (string, error) {
 	setJsonTag(config)
-	// 解析JSON
-	parent := NewNode(DefaultName, "", GroupO, "")
-	var err error
-	if jsonStr[0:1] == "[" {
-		err = jsonparser.ArrayEach([]byte(jsonStr), func(value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (bool, error) {
-			if dataType == jsonparser.Object {
-				err = recursionNode(parent, value, config)
-				if err != nil {
-					return false, err
-				}
-			}
-			return true, nil
-		})
-	} else {
-		err = recursionNode(parent, []byte(jsonStr), config)
-	}
+	// 解析JSON，并合并数组内的对象和属性
+	parent, err := recursionRoot([]byte(strings.TrimSpace(jsonStr)), config)
 	if err != nil {
-		fmt.Println(err)
 		return err.Error(), err
 	}
-	// 合并数组内的对象和属性
-	mergeArrayNode(parent)
+	var enums []*Node
+	if config.EnumFlag {
+		recursionEnum(parent, config)
+	}
+	// 用到的时间戳包装类型，生成类型名称前收集
+	times := make(map[string]bool)
+	if config.TimeFlag {
+		collectTimeTypes(parent, times)
+	}
+	var unions []*Node
+	var polys []*Node
+	var envelopes []*Node
+	var envelopeList []*envelopeDecl
+	var decls []string
+	// 已经使用的类型名称，枚举的常量名不能和它们重名
+	var used map[string]bool
 	var buff bytes.Buffer
 	if config.NestFlag {
 		// 嵌套结构体
-		buff.WriteString(fmt.Sprintf("type %s ", parent.k))
-		nestKey := recursionWrite(parent, config)
-		buff.WriteString(nestKey)
+		if config.EnumFlag {
+			collectEnums(parent, &enums)
+		}
+		reserved := reservedNames(times, config)
+		if config.EnvelopeFlag {
+			collectEnvelopes(parent, &envelopes, &envelopeList, config)
+			for _, e := range envelopeList {
+				reserved[e.Name] = true
+			}
+		}
+		named := []*Node{parent}
+		if config.DiscriminatorFlag {
+			if parent.discriminator != "" {
+				// 根节点是多态类型，包装类型添加Elem后缀
+				parent.name = formatName(rootName(config)+rootElem, config)
+				reserved[parent.name] = true
+			}
+			collectPolymorphic(parent, &polys)
+			// 嵌套结构体时，多态类型的每种类型也需要名称
+			for _, p := range polys {
+				if p != parent {
+					named = append(named, p)
+				}
+				named = append(named, *p.children...)
+			}
+		}
+		var recursives []*Node
+		if config.RecursiveFlag {
+			if isObject(parent.g) && parent.g != GroupO && parent.recursive {
+				// 根节点是树形结构体的数组，结构体名称添加Elem后缀
+				parent.name = formatName(rootName(config)+rootElem, config)
+				reserved[parent.name] = true
+			} else if parent.g == GroupO {
+				parent.name = formatName(rootName(config), config)
+			}
+			collectRecursive(parent, &recursives)
+			// 树形结构体需要名称，多态类型的每种类型已经有名称
+			for _, r := range recursives {
+				if r != parent && !isPolymorphicVariant(r) {
+					named = append(named, r)
+				}
+			}
+		}
+		assignEnumNames(append(named, enums...), enums, reserved, config)
+		for _, p := range polys {
+			p.t = p.name
+		}
+		used = usedNames(append(named[1:], enums...), reserved)
+		if config.UnionFlag {
+			collectUnions(parent, &unions)
+			decls = unionDecls(unions, used, config)
+		}
+		decls = append(append(recursiveDecls(recursives, parent, config), envelopeDecls(envelopeList, config)...), decls...)
+		nestKey := parent.k
+		if isObject(parent.g) {
+			nestKey = recursionWrite(parent, config)
+			if parent.g != GroupO && parent.recursive {
+				nestKey = parent.name
+			}
+		}
+		if parent.envelope != nil {
+			// 根节点是包装结构，使用类型别名
+			buff.WriteString(fmt.Sprintf("type %s = %s", formatName(rootName(config), config), envelopeTypeName(parent, config)))
+		} else if groupBase(parent.g) == GroupM {
+			buff.WriteString(fmt.Sprintf("type %s %s", formatName(rootName(config), config), formatNodeType(formatNestKey(parent.k, parent, config), parent, config)))
+		} else {
+			buff.WriteString(rootDecl(formatName(rootName(config), config), formatType(nestKey, parent.t, parent.g, config.PointerFlag && parent.g != GroupO), parent))
+		}
 	} else {
+		// 结构体名称使用单独的命名空间
+		reserved := reservedNames(times, config)
+		if parent.g != GroupO {
+			// 根节点是数组、map或者基础类型，对象的结构体名称添加Elem后缀，map使用值对应的结构体
+			elem := structNode(parent)
+			elem.name = formatName(rootName(config)+rootElem, config)
+			reserved[elem.name] = true
+			if elem.discriminator != "" {
+				elem.t = elem.name
+			}
+			rootType := formatType(elem.name, parent.t, parent.g, config.PointerFlag)
+			if groupBase(parent.g) == GroupM {
+				rootType = formatNodeType(elem.name, parent, config)
+			}
+			buff.WriteString(rootDecl(formatName(rootName(config), config), rootType, parent))
+		} else {
+			parent.name = formatName(rootName(config), config)
+		}
 		all := make([]*Node, 0)
 		recursionAdd(&all, parent)
-		// 格式化前name；格式化后name
-		nameMap := make(map[string]string)
-		// 转换后的name，如果重名了，后面加数字表示
-		nameCount := make(map[string]int)
-		for i, a := range all {
-			buff.WriteString(fmt.Sprintf("type %s struct {\n", formatKey(nameMap, nameCount, a.k)))
+		if config.DedupFlag {
+			// 结构相同的结构体只生成一个
+			dedupNode(all, config)
+		}
+		if config.EnumFlag {
+			collectEnums(parent, &enums)
+		}
+		if config.EnvelopeFlag {
+			collectEnvelopes(parent, &envelopes, &envelopeList, config)
+			for _, e := range envelopeList {
+				reserved[e.Name] = true
+			}
+		}
+		assignEnumNames(append(all, enums...), enums, reserved, config)
+		if config.DiscriminatorFlag {
+			collectPolymorphic(parent, &polys)
+			for _, p := range polys {
+				p.t = p.name
+			}
+		}
+		used = usedNames(append(all, enums...), reserved)
+		if config.UnionFlag {
+			collectUnions(parent, &unions)
+			decls = unionDecls(unions, used, config)
+		}
+		// 包装结构的类型由数据的类型决定，先处理子节点
+		for i := len(envelopes) - 1; i >= 0; i-- {
+			envelopes[i].name = envelopeTypeName(envelopes[i], config)
+		}
+		decls = append(envelopeDecls(envelopeList, config), decls...)
+		for _, a := range all {
+			// 多态类型在后面单独生成
+			if a.ref != nil || a.discriminator != "" {
+				continue
+			}
+			if a.envelope != nil {
+				if a == parent {
+					// 根节点是包装结构，使用类型别名
+					buff.WriteString(fmt.Sprintf("type %s = %s", formatName(rootName(config), config), a.name))
+				}
+				continue
+			}
+			if buff.Len() > 0 {
+				buff.WriteString("\n\n")
+			}
+			buff.WriteString(fmt.Sprintf("type %s struct {\n", a.name))
+			// 格式化前name；格式化后name，属性名只需要在结构体内不重复
+			nameMap := make(map[string]string)
+			// 转换后的name，如果重名了，后面加数字表示
+			nameCount := make(map[string]int)
 			for _, node := range *a.children {
 				if node.c != "" && config.Comment == Comment1 {
 					buff.WriteString(node.c + "\n")
 				}
-				key := formatKey(nameMap, nameCount, node.k)
+				key := fieldName(nameMap, nameCount, node, config)
+				typeName := key
+				if needTypeName(node) {
+					typeName = refNode(structNode(node)).name
+				}
 				if node.c != "" && config.Comment == Comment2 {
-					buff.WriteString(fmt.Sprintf("%s %s %s %s\n", key, formatType(key, node.t, node.g, config.PointerFlag), formatTag(node.k, config.Tags), node.c))
+					buff.WriteString(fmt.Sprintf("%s %s %s %s\n", key, formatNodeType(typeName, node, config), nodeTag(node, config), node.c))
 				} else {
-					buff.WriteString(fmt.Sprintf("%s %s %s\n", key, formatType(key, node.t, node.g, config.PointerFlag), formatTag(node.k, config.Tags)))
+					buff.WriteString(fmt.Sprintf("%s %s %s\n", key, formatNodeType(typeName, node, config), nodeTag(node, config)))
 				}
 			}
-			if i == len(all)-1 {
-				buff.WriteString("}")
-			} else {
-				buff.WriteString("}\n\n")
-			}
+			buff.WriteString("}")
 		}
 	}
+	decls = append(decls, polymorphicDecls(polys, config)...)
+	for _, decl := range decls {
+		buff.WriteString("\n\n" + decl)
+	}
+	writeEnumTypes(&buff, enums, used, config)
+	writeTimeTypes(&buff, times)
 	source, err := format.Source(buff.Bytes())
 	if err != nil {
-		fmt.Println(err)
 		return err.Error(), err
 	}
+	if config.PackageName != "" {
+		source, err = formatFile(source, config)
+		if err != nil {
+			return err.Error(), err
+		}
+	}
 	return string(source), nil
 }
 
+// 生成完整的go文件，根据类型声明中使用到的包自动添加import
+func formatFile(source []byte, config *Config) ([]byte, error) {
+	var buff bytes.Buffer
+	if config.FileHeader != "" {
+		for _, line := range strings.Split(strings.TrimSpace(config.FileHeader), "\n") {
+			line = strings.TrimSpace(line)
+			if !strings.HasPrefix(line, "//") {
+				line = "// " + line
+			}
+			buff.WriteString(line + "\n")
+		}
+		buff.WriteString("\n")
+	}
+	buff.WriteString(fmt.Sprintf("package %s\n\n", config.PackageName))
+	imports, err := collectImports(source)
+	if err != nil {
+		return nil, err
+	}
+	if len(imports) > 0 {
+		buff.WriteString("import (\n")
+		for _, i := range imports {
+			buff.WriteString(strconv.Quote(i) + "\n")
+		}
+		buff.WriteString(")\n\n")
+	}
+	buff.Write(source)
+	buff.WriteString("\n")
+	return format.Source(buff.Bytes())
+}
+
+// 解析类型声明，收集使用到的包
+func collectImports(source []byte) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", append([]byte("package p\n\n"), source...), 0)
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]struct{})
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			// Obj不为空，说明是局部变量
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Obj == nil {
+				if path, ok := knownImports[ident.Name]; ok {
+					used[path] = struct{}{}
+				}
+			}
+		}
+		return true
+	})
+	imports := make([]string, 0, len(used))
+	for path := range used {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+	return imports, nil
+}
+
+// 解析根节点，根节点是对象时返回对象，是数组或者基础类型时返回对应的属性
+func recursionRoot(data []byte, config *Config) (*Node, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyJSON
+	}
+	value, dataType, _, err := jsonparser.Get(data)
+	if err != nil {
+		return nil, err
+	}
+	// 根节点是map时，作为虚拟对象的属性来解析
+	if dataType == jsonparser.Object && !isMap(rootPath, [][]byte{value}, config) {
+		parent := NewNode(rootName(config), "", GroupO, "")
+		parent.p = rootPath
+		err = recursionNode(parent, value, config)
+		if err != nil {
+			return nil, err
+		}
+		mergeArrayNode(parent, config)
+		recursionConvert(parent, config)
+		return parent, nil
+	}
+	// 作为一个虚拟对象的属性来解析
+	parent := NewNode("", "", GroupO, "")
+	parent.virtual = true
+	parent.count++
+	err = recursionValue(parent, rootName(config), rootPath, value, dataType, "", config)
+	if err != nil {
+		return nil, err
+	}
+	mergeArrayNode(parent, config)
+	recursionConvert(parent, config)
+	root := (*parent.children)[0]
+	root.parent = nil
+	return root, nil
+}
+
+// 合并后根据配置转换类型
+func recursionConvert(node *Node, config *Config) {
+	if node.hint.t != "" {
+		// 注解指定的类型不转换
+	} else if node.t == TypeInt && config.Int64Flag {
+		node.t = TypeInt64
+	} else if node.t == TypeNumber && config.BigIntFlag {
+		node.t = TypeBigInt
+	}
+	for _, n := range *node.children {
+		recursionConvert(n, config)
+	}
+}
+
 func NewNode(k, t, g, c string) *Node {
 	node := &Node{
 		k: k,
@@ -195,37 +580,101 @@ func NewNode(k, t, g, c string) *Node {
 	return node
 }
 
-func mergeArrayNode(parent *Node) {
-	for _, node := range *parent.childrenMerge {
-		addChildren(parent, walkNode(node))
-	}
+func newPathNode(k, t, g, c, p string) *Node {
+	node := NewNode(k, t, g, c)
+	node.p = p
+	return node
 }
 
-// nodes是一个属性
-func walkNode(nodes []*Node) *Node {
+// nodes是一个属性，count是父对象的样本数量
+func walkNode(nodes []*Node, count int, config *Config) *Node {
+	if config.UnionFlag {
+		if variants := unionVariants(nodes); variants != nil {
+			return walkUnion(nodes, variants, count, config)
+		}
+	}
+	if config.DiscriminatorFlag {
+		if key, values, groups := findDiscriminator(nodes, config); key != "" {
+			return walkPolymorphic(nodes, key, values, groups, count, config)
+		}
+	}
 	parent := mergeNode(nodes)
+	// 属性出现的次数少于父对象的样本数量，说明部分样本中缺失
+	parent.optional = len(nodes) < count || hasNil(nodes)
+	mergeArrayNode(parent, config)
+	return parent
+}
+
+// 合并子节点，注解忽略的属性不添加
+func mergeArrayNode(parent *Node, config *Config) {
+	var keys map[string]bool
+	if config.RecursiveFlag {
+		keys = mergeRecursive(parent, config)
+	}
 	for _, node := range *parent.childrenMerge {
-		addChildren(parent, walkNode(node))
+		child := walkNode(node, childCount(parent), config)
+		if child.hint.skip {
+			continue
+		}
+		if keys[child.k] && isObject(child.g) {
+			// 自引用的属性
+			child.ref = parent
+		}
+		addChildren(parent, child)
 	}
-	return parent
+}
+
+// 父对象的样本数量，map的值不存在缺失的情况
+func childCount(parent *Node) int {
+	if groupBase(parent.g) == GroupM {
+		return 0
+	}
+	return parent.count
+}
+
+// 属性是否在部分样本中为null
+func hasNil(nodes []*Node) bool {
+	for _, node := range nodes {
+		if node.g == GroupV && node.t == TypeNil {
+			return true
+		}
+	}
+	return false
 }
 
 func mergeNode(nodes []*Node) *Node {
 	n := NewNode(nodes[0].k, "", "", "")
+	n.p = nodes[0].p
 	group, t := mergeGroupAndType(nodes)
 	n.g = group
 	n.t = t
 	n.c = mergeComment(nodes)
+	n.hint = mergeAnnotation(nodes)
 	for _, node := range nodes {
+		n.count += node.count
+		n.values = append(n.values, node.values...)
 		for _, n1 := range *node.childrenMerge {
 			for _, n2 := range n1 {
 				addChildrenMerge(n, n2)
 			}
 		}
 	}
+	if n.hint.t != "" {
+		// 注解指定了类型，不需要子节点
+		n.g = GroupV
+		n.t = n.hint.t
+		n.childrenMerge = &[][]*Node{}
+	}
 	return n
 }
 
+func rootName(config *Config) string {
+	if config.RootName != "" {
+		return config.RootName
+	}
+	return DefaultName
+}
+
 func setJsonTag(config *Config) {
 	flag := false
 	for _, tag := range config.Tags {
@@ -243,8 +692,8 @@ func setJsonTag(config *Config) {
 }
 
 func recursionAdd(all *[]*Node, node *Node) {
-	// 支持没有属性的struct
-	if node.g == GroupO || node.g == GroupO1 || node.g == GroupO2 {
+	// 支持没有属性的struct，引用其他结构体的除外，多态类型也需要名称
+	if isObject(node.g) && node.ref == nil || node.discriminator != "" {
 		*all = append(*all, node)
 	}
 	for _, n := range *node.children {
@@ -263,21 +712,38 @@ func recursionWrite(parent *Node, config *Config) string {
 		if node.c != "" && config.Comment == Comment1 {
 			res.WriteString(node.c + "\n")
 		}
-		key := formatKey(nameMap, nameCount, node.k)
-		nestKey := key
-		if len(*node.children) > 0 {
-			nestKey = recursionWrite(node, config)
-		}
+		key := fieldName(nameMap, nameCount, node, config)
+		nestKey := formatNestKey(key, node, config)
 		if node.c != "" && config.Comment == Comment2 {
-			res.WriteString(fmt.Sprintf("%s %s %s %s\n", key, formatType(nestKey, node.t, node.g, config.PointerFlag), formatTag(node.k, config.Tags), node.c))
+			res.WriteString(fmt.Sprintf("%s %s %s %s\n", key, formatNodeType(nestKey, node, config), nodeTag(node, config), node.c))
 		} else {
-			res.WriteString(fmt.Sprintf("%s %s %s\n", key, formatType(nestKey, node.t, node.g, config.PointerFlag), formatTag(node.k, config.Tags)))
+			res.WriteString(fmt.Sprintf("%s %s %s\n", key, formatNodeType(nestKey, node, config), nodeTag(node, config)))
 		}
 	}
 	res.WriteString("}")
 	return res.String()
 }
 
+// 嵌套结构体的类型，map使用值的类型
+func formatNestKey(key string, node *Node, config *Config) string {
+	if groupBase(node.g) == GroupM {
+		if len(*node.children) > 0 {
+			return formatNestKey(key, (*node.children)[0], config)
+		}
+		return key
+	}
+	if node.ref != nil {
+		return refNode(node).name
+	}
+	if node.envelope != nil {
+		return envelopeTypeName(node, config)
+	}
+	if len(*node.children) > 0 && !node.union && node.discriminator == "" {
+		return nestType(node, config)
+	}
+	return key
+}
+
 func mergeComment(nodes []*Node) string {
 	comment := ""
 	for _, p := range nodes {
@@ -293,16 +759,36 @@ func mergeComment(nodes []*Node) string {
 func mergeGroupAndType(array []*Node) (group string, t string) {
 	var groups []string
 	var types []string
+	mapFlag := false
+	for _, p := range array {
+		if groupBase(p.g) == GroupM {
+			mapFlag = true
+		}
+	}
+	// null和任何大类型都兼容，只在属性都是null时作为大类型，例如对象和null合并为对象
+	var nilGroups []string
 	for _, p := range array {
-		groups = append(groups, p.g)
+		g := p.g
+		if mapFlag && isObject(g) && len(*p.childrenMerge) == 0 {
+			// 空对象可以作为map
+			g = newGroup(GroupM, groupDepth(g))
+		}
+		if g == GroupV && p.t == TypeNil {
+			nilGroups = append(nilGroups, g)
+		} else {
+			groups = append(groups, g)
+		}
 		types = append(types, p.t)
 	}
+	if len(groups) == 0 {
+		groups = nilGroups
+	}
 	flag, group := mergeFiledGroup(groups)
 	if flag {
 		// type类型确定
 		return group, TypeAny
 	}
-	if isObject(group) {
+	if isObject(group) || groupBase(group) == GroupM {
 		// 对象类型，不需要t
 		return group, TypeAny
 	}
@@ -313,140 +799,150 @@ func mergeGroupAndType(array []*Node) (group string, t string) {
 
 // 获取大类型group，判断小类型是否是any
 func mergeFiledGroup(array []string) (bool, string) {
-	VFlag := false
-	V1Flag := false
-	V2Flag := false
-	OFlag := false
-	O1Flag := false
-	O2Flag := false
-	Nil1Flag := false
-	Nil2Flag := false
+	// 不同的大类型，空数组单独处理
+	groups := make(map[string]struct{})
+	group := ""
+	nilDepth := 0
 	for _, g := range array {
-		switch g {
-		case GroupV:
-			VFlag = true
-		case GroupV1:
-			V1Flag = true
-		case GroupV2:
-			V2Flag = true
-		case GroupO:
-			OFlag = true
-		case GroupO1:
-			O1Flag = true
-		case GroupO2:
-			O2Flag = true
-		case GroupNil1:
-			Nil1Flag = true
-		case GroupNil2:
-			Nil2Flag = true
+		if groupBase(g) == "" {
+			// 空数组，取最大的维度
+			if depth := groupDepth(g); depth > nilDepth {
+				nilDepth = depth
+			}
+			continue
 		}
-	}
-	count := 0
-	if VFlag {
-		count++
-	}
-	if V1Flag {
-		count++
-	}
-	if V2Flag {
-		count++
-	}
-	if OFlag {
-		count++
-	}
-	if O1Flag {
-		count++
-	}
-	if O2Flag {
-		count++
+		groups[g] = struct{}{}
+		group = g
 	}
 	// 类型是any的情况 返回any
-	if count > 1 || Nil1Flag && Nil2Flag {
+	if len(groups) > 1 {
 		return true, GroupV
 	}
-	if Nil1Flag && (VFlag || V2Flag || OFlag || O2Flag) {
-		return true, GroupV
+	if len(groups) == 0 {
+		// 只有空数组，返回 []any [][]any ...
+		return true, newGroup(GroupV, nilDepth)
 	}
-	if Nil2Flag && (VFlag || V1Flag || OFlag || O1Flag) {
+	// 空数组的维度不能超过其他数组的维度
+	if nilDepth > groupDepth(group) {
 		return true, GroupV
 	}
-	if count == 0 {
-		if Nil1Flag {
-			// 返回 []any
-			return true, GroupV1
-		} else {
-			// 返回 [][]any
-			return true, GroupV2
-		}
-	}
 	// 类型不是any
-	if VFlag {
-		return false, GroupV
-	} else if V1Flag {
-		// 不需要考虑Nil1Flag
-		return false, GroupV1
-	} else if V2Flag {
-		return false, GroupV2
-	} else if OFlag {
-		return false, GroupO
-	} else if O1Flag {
-		return false, GroupO1
-	} else {
-		return false, GroupO2
-	}
+	return false, group
+}
+
+// 数组的维度
+func groupDepth(group string) int {
+	return strings.Count(group, groupArray)
 }
 
-// 格式化对象名，属性名，并且通过cache来解决全局重名问题
-// 对象名不能重复；属性名要考虑多个对象之间的一致性，所以要保持全局统一的映射关系
-func formatKey(nameMap map[string]string, nameCount map[string]int, key string) string {
+// 去掉数组维度后的基础类型，Value或者Object，空数组返回空
+func groupBase(group string) string {
+	return strings.ReplaceAll(group, groupArray, "")
+}
+
+func newGroup(base string, depth int) string {
+	return strings.Repeat(groupArray, depth) + base
+}
+
+// 格式化属性名，并且通过cache来解决结构体内的重名问题
+func formatKey(nameMap map[string]string, nameCount map[string]int, key string, config *Config) string {
 	if e, ok := nameMap[key]; ok {
 		return e
 	}
-	result := ""
-	// 将驼峰式命名转换为下划线分割
-	newKey := convertToUnderline(key)
-	// 按下划线分割，每个片段的首字母大写
-	split := strings.Split(newKey, "_")
-	for i, str := range split {
-		span := ""
-		for j, v := range str {
-			s := ""
-			if isLetter(v) {
-				s = string(v)
-			} else if isDigit(v) {
-				s = string(v)
-			} else if unicode.Is(unicode.Han, v) {
-				// 处理中文字符
-				s = convertToPinyin(string(v))
-			}
-			if s == "" {
-				continue
+	result := formatName(key, config)
+	if result == "" {
+		// 无法生成名称时使用Field加数字
+		for i := 1; ; i++ {
+			if _, ok := nameCount[defaultFieldName+strconv.Itoa(i)]; !ok {
+				result = defaultFieldName + strconv.Itoa(i)
+				break
 			}
-			if j == 0 {
-				s = strings.ToUpper(s)
-			}
-			if i == 0 && span == "" {
-				// 首字母如果是数字，就转换
-				s = numToLetter(s)
+		}
+	}
+	result = uniqueName(nameCount, result)
+	nameMap[key] = result
+	return result
+}
+
+// 判断是否重名，重名时末尾加数字
+func uniqueName(nameCount map[string]int, name string) string {
+	result := name
+	if count, ok := nameCount[name]; ok {
+		for {
+			count++
+			if _, ok = nameCount[name+strconv.Itoa(count)]; !ok {
+				break
 			}
-			span += s
 		}
-		span = convertInitialisms(span)
-		result += span
+		nameCount[name] = count
+		result = name + strconv.Itoa(count)
 	}
-	result = convertInitialisms(result)
-	// 判断是否重名
-	if count, ok := nameCount[result]; ok {
-		// 重名了，末尾加数字
-		result = result + strconv.Itoa(count+1)
-		nameCount[result] = count + 1
-	} else {
-		nameCount[result] = 0
+	nameCount[result] = 0
+	return result
+}
+
+// 属性名，优先使用注解指定的属性名
+func fieldName(nameMap map[string]string, nameCount map[string]int, node *Node, config *Config) string {
+	if e, ok := nameMap[node.k]; ok {
+		return e
+	}
+	// 注解或者规则指定的名称也需要是合法的标识符，并且不能重名
+	if name := sanitizeName(node.hint.name); name != "" {
+		name = uniqueName(nameCount, name)
+		nameMap[node.k] = name
+		return name
+	}
+	return formatKey(nameMap, nameCount, node.k, config)
+}
+
+// 是否添加omitempty，属性在部分样本中缺失或者注解指定
+func isOmitEmpty(node *Node, config *Config) bool {
+	return node.optional && config.OmitEmptyFlag || node.hint.omitEmpty
+}
+
+// 格式化对象名，属性名，使用配置的命名策略，保证是合法的导出标识符，无法生成时返回空
+func formatName(key string, config *Config) string {
+	result := sanitizeName(getConfigNamer(config).Name(applyGlossary(key, config.Glossary), config))
+	if result == "" {
+		// 只有符号的key，使用符号的名称，例如 @ 生成 At
+		var buffer strings.Builder
+		for _, v := range key {
+			buffer.WriteString(symbolNames[v])
+		}
+		result = buffer.String()
 	}
-	nameMap[key] = result
 	return result
 }
 
+// 去掉标识符中的非法字符，首字母是数字时转换，首字母大写
+func sanitizeName(name string) string {
+	var buffer strings.Builder
+	for _, v := range name {
+		if unicode.IsLetter(v) || unicode.IsDigit(v) || v == '_' && buffer.Len() > 0 {
+			if buffer.Len() == 0 && unicode.IsDigit(v) {
+				buffer.WriteString(numToLetter(string(v)))
+				continue
+			}
+			buffer.WriteRune(v)
+		}
+	}
+	return upperFirst(buffer.String())
+}
+
+// 格式化结构体名称，添加前缀后缀
+func formatTypeName(key string, config *Config) string {
+	var array []string
+	for _, s := range []string{config.TypePrefix, key, config.TypeSuffix} {
+		if s != "" {
+			array = append(array, s)
+		}
+	}
+	if name := formatName(strings.Join(array, "_"), config); name != "" {
+		return name
+	}
+	return defaultTypeName
+}
+
 func convertToUnderline(key string) string {
 	var buffer bytes.Buffer
 	runes := []rune(key)
@@ -474,9 +970,44 @@ func isDigit(r rune) bool {
 	return r >= '0' && r <= '9'
 }
 
-// 中文字符转拼音首字母，返回小写
-func convertToPinyin(s string) string {
-	return GetPinYin(s)
+// 连续的中文字符转拼音，返回小写，完整拼音模式每个音节一个片段，否则只有一个拼音首字母组成的片段
+func convertToPinyin(s string, config *Config) []string {
+	if config.FullPinYinFlag {
+		return GetFullPinYin(s)
+	}
+	var buffer strings.Builder
+	for _, v := range s {
+		buffer.WriteString(GetPinYin(string(v)))
+	}
+	return []string{buffer.String()}
+}
+
+// 格式化属性的类型，根据配置判断是否使用指针
+func formatNodeType(key string, node *Node, config *Config) string {
+	if groupBase(node.g) == GroupM {
+		// map的值类型由子节点决定
+		value := TypeAny
+		if len(*node.children) > 0 {
+			value = formatNodeType(key, (*node.children)[0], config)
+		}
+		return strings.Repeat(groupArray, groupDepth(node.g)) + "map[string]" + value
+	}
+	// 数组和any本身可以为nil，不需要指针
+	optional := config.AutoPointerFlag && node.optional && groupDepth(node.g) == 0
+	// 引用自己的属性使用指针
+	result := formatType(key, node.t, node.g, config.PointerFlag || optional && isObject(node.g) || isSelfRef(node))
+	if optional && node.g == GroupV && node.t != TypeAny && !strings.HasPrefix(result, "*") {
+		result = "*" + result
+	}
+	return result
+}
+
+// 根节点的类型声明，根节点是基础类型时使用类型别名，保留 time.Time json.Number 等类型的方法
+func rootDecl(name string, t string, root *Node) string {
+	if root.g == GroupV {
+		return fmt.Sprintf("type %s = %s", name, t)
+	}
+	return fmt.Sprintf("type %s %s", name, t)
 }
 
 // 格式化完整的类型
@@ -486,162 +1017,221 @@ func formatType(key string, t string, group string, pointerFlag bool) string {
 	if pointerFlag {
 		pointer = "*"
 	}
-	if group == GroupO {
-		result = pointer + key
-	} else if group == GroupO1 {
-		result = "[]" + pointer + key
-	} else if group == GroupO2 {
-		result = "[][]" + pointer + key
-	} else if group == GroupV1 {
-		result = "[]" + t
-	} else if group == GroupV2 {
-		result = "[][]" + t
+	array := strings.Repeat(groupArray, groupDepth(group))
+	switch groupBase(group) {
+	case GroupO:
+		result = array + pointer + key
+	case GroupV:
+		result = array + t
+	default:
+		result = array + TypeAny
 	}
 	return result
 }
 
-// 格式化tag
-func formatTag(key string, tag []string) string {
-	result := "`"
+// 格式化tag，optional为true时，根据配置自动添加omitempty
+// 属性的tag，规则指定的tag选项优先
+func nodeTag(node *Node, config *Config) string {
+	options := config.TagOptions
+	if len(node.hint.tagOptions) > 0 {
+		options = make(map[string]string)
+		for k, v := range config.TagOptions {
+			options[k] = v
+		}
+		for k, v := range node.hint.tagOptions {
+			options[k] = v
+		}
+	}
+	return formatTag(node.k, isOmitEmpty(node, config), options, config.Tags)
+}
+
+func formatTag(key string, omitEmpty bool, tagOptions map[string]string, tags []string) string {
 	var array []string
-	for _, t := range tag {
-		s := fmt.Sprintf("%s:%q", t, key)
+	for _, t := range tags {
+		options := tagOptions[t]
+		value := key
+		if options == "-" {
+			// 忽略这个属性
+			value = options
+		} else {
+			if omitEmpty && !hasTagOption(options, "omitempty") {
+				options = strings.Join(append(splitTagOptions(options), "omitempty"), ",")
+			}
+			if options != "" {
+				value += "," + options
+			}
+		}
+		if value == "-" && key == "-" {
+			// key是"-"时需要加逗号，否则会被忽略
+			value = "-,"
+		}
+		s := fmt.Sprintf("%s:%q", t, value)
 		array = append(array, s)
 	}
-	result += strings.Join(array, " ")
-	result += "`"
+	result := strings.Join(array, " ")
+	if strings.Contains(result, "`") {
+		// key包含反引号时不能使用原始字符串
+		return strconv.Quote(result)
+	}
+	return "`" + result + "`"
+}
+
+func splitTagOptions(options string) []string {
+	var result []string
+	for _, o := range strings.Split(options, ",") {
+		if o = strings.TrimSpace(o); o != "" {
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+func hasTagOption(options string, option string) bool {
+	for _, o := range splitTagOptions(options) {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTagOptions 解析tag选项，多个tag以英文分号隔开，例如 json:omitempty;bson:omitempty,inline
+func ParseTagOptions(str string) map[string]string {
+	result := make(map[string]string)
+	for _, s := range strings.Split(str, ";") {
+		split := strings.SplitN(s, ":", 2)
+		if len(split) != 2 || strings.TrimSpace(split[0]) == "" {
+			continue
+		}
+		result[strings.TrimSpace(split[0])] = strings.Join(splitTagOptions(split[1]), ",")
+	}
 	return result
 }
 
 func recursionNode(parent *Node, data []byte, config *Config) error {
-	var err error
-	var group, t, c string
-	var arrayObj [][]byte
-	err = jsonparser.ObjectEach(data, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
-		group, err = getGroup(value, dataType)
+	parent.count++
+	err := jsonparser.ObjectEach(data, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
+		err = recursionValue(parent, string(key), joinPath(elemPath(parent), string(key)), value, dataType, string(comment), config)
 		if err != nil {
 			return false, err
 		}
-		switch group {
-		case GroupV:
-			addChildrenMerge(parent, NewNode(string(key), getJSONType(value, dataType), group, string(comment)))
-		case GroupV1:
-			t, c, err = getJSONArrayType(value, 1)
-			if err != nil {
-				return false, err
-			}
-			// 优先使用数组的注释，不存在时，在使用从属性里提取出来的注释
-			if len(comment) > 0 {
-				c = string(comment)
-			}
-			addChildrenMerge(parent, NewNode(string(key), t, group, c))
-		case GroupV2:
-			t, c, err = getJSONArrayType(value, 2)
-			if err != nil {
-				return false, err
-			}
-			if len(comment) > 0 {
-				c = string(comment)
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// 解析对象的一个属性
+func recursionValue(parent *Node, key string, path string, value []byte, dataType jsonparser.ValueType, comment string, config *Config) error {
+	hint, comment := parseAnnotation(comment)
+	applyRules(&hint, path, config)
+	if hint.t != "" {
+		// 注解指定了类型，不再解析
+		node := newPathNode(key, hint.t, GroupV, comment, path)
+		node.hint = hint
+		addChildrenMerge(parent, node)
+		return nil
+	}
+	group, err := getGroup(value, dataType)
+	if err != nil {
+		return err
+	}
+	depth := groupDepth(group)
+	switch groupBase(group) {
+	case GroupV:
+		if depth == 0 {
+			node := newPathNode(key, getValueType(key, value, dataType, config), group, comment, path)
+			node.hint = hint
+			// 按json的类型收集，识别为时间的字符串和其他样本合并为字符串时也需要这个值
+			if (config.EnumFlag || config.DiscriminatorFlag) && dataType == jsonparser.String {
+				node.values = collectStrings(value, dataType, depth)
 			}
-			addChildrenMerge(parent, NewNode(string(key), t, group, c))
-		case GroupO:
-			node := NewNode(string(key), string(key), group, string(comment))
 			addChildrenMerge(parent, node)
-			err = recursionNode(node, value, config)
-			if err != nil {
-				return false, err
-			}
-		case GroupO1:
-			arrayObj, c, err = getArrayObj(value, 1)
+			break
+		}
+		t, c, err := getJSONArrayType(key, value, depth, config)
+		if err != nil {
+			return err
+		}
+		// 优先使用数组的注释，不存在时，在使用从属性里提取出来的注释
+		if len(comment) > 0 {
+			c = comment
+		}
+		node := newPathNode(key, t, group, c, path)
+		node.hint = hint
+		if config.EnumFlag || config.DiscriminatorFlag {
+			node.values = collectStrings(value, dataType, depth)
+		}
+		addChildrenMerge(parent, node)
+	case GroupO:
+		arrayObj := [][]byte{value}
+		c := comment
+		if depth > 0 {
+			arrayObj, c, err = getArrayObj(value, depth)
 			if err != nil {
-				return false, err
+				return err
 			}
 			if len(comment) > 0 {
-				c = string(comment)
+				c = comment
 			}
+		}
+		if hint.mapFlag || isMap(path, arrayObj, config) {
+			group = newGroup(GroupM, depth)
+		}
 
-			node := NewNode(string(key), string(key), group, c)
-			addChildrenMerge(parent, node)
+		node := newPathNode(key, key, group, c, path)
+		node.hint = hint
+		addChildrenMerge(parent, node)
 
-			for _, obj := range arrayObj {
+		for _, obj := range arrayObj {
+			if groupBase(group) == GroupM {
+				err = recursionMap(node, obj, config)
+			} else if config.DiscriminatorFlag {
+				err = recursionSample(node, obj, config)
+			} else {
 				err = recursionNode(node, obj, config)
-				if err != nil {
-					return false, err
-				}
 			}
-		case GroupO2:
-			arrayObj, c, err = getArrayObj(value, 2)
 			if err != nil {
-				return false, err
+				return err
 			}
-			if len(comment) > 0 {
-				c = string(comment)
-			}
-
-			node := NewNode(string(key), string(key), group, c)
-			addChildrenMerge(parent, node)
-
-			for _, obj := range arrayObj {
-				err = recursionNode(node, obj, config)
-				if err != nil {
-					return false, err
-				}
-			}
-		case GroupNil1:
-			addChildrenMerge(parent, NewNode(string(key), TypeNil, group, ""))
-		case GroupNil2:
-			addChildrenMerge(parent, NewNode(string(key), TypeNil, group, ""))
 		}
-		return true, nil
-	})
-	if err != nil {
-		return err
+	default:
+		// 空数组
+		node := newPathNode(key, TypeNil, group, "", path)
+		node.hint = hint
+		addChildrenMerge(parent, node)
 	}
 	return nil
 }
 
+// 获取大类型，数组以第一个不为空数组的元素为准，都是空数组时取最大的维度
 func getGroup(value []byte, dataType jsonparser.ValueType) (string, error) {
 	group := ""
 	var err error
 	if dataType == jsonparser.Array {
-		count1 := 0
-		// 二维数组的第一个元素如果是空，继续判断
-		nextFlag := true
+		count := 0
 		err = jsonparser.ArrayEach(value, func(value2 []byte, dataType2 jsonparser.ValueType, offset2 int, comment []byte) (flag bool, err error) {
-			count1++
-			if nextFlag {
-				if dataType2 == jsonparser.Object {
-					group = GroupO1
-					nextFlag = false
-				} else if dataType2 == jsonparser.Array {
-					count2 := 0
-					err = jsonparser.ArrayEach(value2, func(value3 []byte, dataType3 jsonparser.ValueType, offset3 int, comment []byte) (flag bool, err error) {
-						count2++
-						nextFlag = false
-						if dataType3 == jsonparser.Object {
-							group = GroupO2
-						} else {
-							group = GroupV2
-						}
-						return false, nil
-					})
-					if err != nil {
-						return false, err
-					}
-					if count1 == 1 && count2 == 0 {
-						group = GroupNil2
-					}
-				} else {
-					group = GroupV1
-					nextFlag = false
-				}
+			count++
+			group2, err := getGroup(value2, dataType2)
+			if err != nil {
+				return false, err
+			}
+			if groupBase(group2) != "" {
+				group = groupArray + group2
+				return false, nil
 			}
-			return nextFlag, nil
+			// 数组的元素是空数组，继续判断
+			if groupDepth(group2)+1 > groupDepth(group) {
+				group = groupArray + group2
+			}
+			return true, nil
 		})
 		if err != nil {
 			return "", err
 		}
-		if count1 == 0 {
+		if count == 0 {
 			group = GroupNil1
 		}
 	} else if dataType == jsonparser.Object {
@@ -653,6 +1243,7 @@ func getGroup(value []byte, dataType jsonparser.ValueType) (string, error) {
 }
 
 func addChildren(parent *Node, node *Node) {
+	node.parent = parent
 	*parent.children = append(*parent.children, node)
 }
 
@@ -666,19 +1257,19 @@ func addChildrenMerge(parent *Node, node *Node) {
 	}
 }
 
-// 获取数组内所有的对象
-func getArrayObj(data []byte, count int) (result [][]byte, c string, err error) {
+// 获取数组内所有的对象，depth是数组的维度
+func getArrayObj(data []byte, depth int) (result [][]byte, c string, err error) {
 	err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
-		if count == 1 {
-			result = append(result, value)
-		} else {
-			err = jsonparser.ArrayEach(value, func(value2 []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
-				result = append(result, value2)
-				return true, nil
-			})
+		if depth == 1 {
+			if dataType == jsonparser.Object {
+				result = append(result, value)
+			}
+		} else if dataType == jsonparser.Array {
+			array, _, err := getArrayObj(value, depth-1)
 			if err != nil {
 				return false, err
 			}
+			result = append(result, array...)
 		}
 		// 注释只提取外层的
 		if c == "" && string(comment) != "" {
@@ -699,8 +1290,16 @@ func mergeFiledType(array []string, flag bool) string {
 	float64Flag := false
 	intFlag := false
 	int64Flag := false
+	uint64Flag := false
+	numberFlag := false
+	negIntFlag := false
+	negInt64Flag := false
 	anyFlag := false
 	nilFlag := false
+	timeFlag := false
+	dateFlag := false
+	unixFlag := false
+	unixMilliFlag := false
 	for _, t := range array {
 		switch t {
 		case TypeString:
@@ -713,21 +1312,39 @@ func mergeFiledType(array []string, flag bool) string {
 			intFlag = true
 		case TypeInt64:
 			int64Flag = true
+		case TypeUint64:
+			uint64Flag = true
+		case TypeNumber:
+			numberFlag = true
+		case TypeNegInt:
+			negIntFlag = true
+		case TypeNegInt64:
+			negInt64Flag = true
 		case TypeAny:
 			anyFlag = true
 		case TypeNil:
 			nilFlag = true
+		case TypeTime:
+			timeFlag = true
+		case TypeDate:
+			dateFlag = true
+		case TypeUnixTime:
+			unixFlag = true
+		case TypeUnixMilliTime:
+			unixMilliFlag = true
 		}
 	}
 	if anyFlag {
 		return TypeAny
 	}
 	count := 0
-	// 将这三种类型，统一合并为数字类型
-	if float64Flag || intFlag || int64Flag {
+	// 将这几种类型，统一合并为数字类型
+	negFlag := negIntFlag || negInt64Flag
+	if float64Flag || intFlag || int64Flag || uint64Flag || numberFlag || negFlag || unixFlag || unixMilliFlag {
 		count++
 	}
-	if stringFlag {
+	// 时间和日期，统一合并为字符串类型
+	if stringFlag || timeFlag || dateFlag {
 		count++
 	}
 	if boolFlag {
@@ -737,17 +1354,35 @@ func mergeFiledType(array []string, flag bool) string {
 		// 代表出现了不同的类型
 		return TypeAny
 	}
-	if stringFlag {
+	if stringFlag || timeFlag && dateFlag {
+		// 有一个样本不是时间格式，就使用字符串
 		return TypeString
+	} else if timeFlag {
+		return TypeTime
+	} else if dateFlag {
+		return TypeDate
 	} else if boolFlag {
 		return TypeBool
 	} else if float64Flag {
-		// 优先级  float64>int64>int
+		// 优先级  float64>json.Number>uint64>int64>int
 		return TypeFloat64
-	} else if int64Flag {
-		return TypeInt64
-	} else if intFlag {
-		return TypeInt
+	} else if numberFlag || uint64Flag && negFlag {
+		// 超出uint64范围，或者超出int64范围并且有负数
+		return TypeNumber
+	} else if uint64Flag {
+		return TypeUint64
+	} else if unixFlag || unixMilliFlag {
+		// 有一个样本不是时间戳，就使用整数
+		if intFlag || int64Flag || negFlag || unixFlag && unixMilliFlag {
+			return mergeNegType(TypeInt64, negFlag, flag)
+		} else if unixFlag {
+			return TypeUnixTime
+		}
+		return TypeUnixMilliTime
+	} else if int64Flag || negInt64Flag {
+		return mergeNegType(TypeInt64, negFlag, flag)
+	} else if intFlag || negIntFlag {
+		return mergeNegType(TypeInt, negFlag, flag)
 	} else if nilFlag {
 		// 空类型优先级最低，这个地方返回空类型，为了后续类型合并使用
 		if flag {
@@ -758,23 +1393,42 @@ func mergeFiledType(array []string, flag bool) string {
 	return TypeAny
 }
 
-// 合并数组内所有属性的类型
-func getJSONArrayType(data []byte, count int) (result string, c string, err error) {
+// 负数的临时类型，flag为true时保留，为了后续和uint64合并使用
+func mergeNegType(t string, negFlag bool, flag bool) string {
+	if !negFlag || !flag {
+		return t
+	}
+	if t == TypeInt64 {
+		return TypeNegInt64
+	}
+	return TypeNegInt
+}
+
+// 合并数组内所有属性的类型，depth是数组的维度
+func getJSONArrayType(key string, data []byte, depth int, config *Config) (result string, c string, err error) {
 	// 通过数组来推断类型
-	var array []string
+	array, c, err := getJSONArrayTypes(key, data, depth, config)
+	if err != nil {
+		return "", c, err
+	}
+	return mergeFiledType(array, true), c, nil
+}
+
+func getJSONArrayTypes(key string, data []byte, depth int, config *Config) (array []string, c string, err error) {
 	err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
-		if count == 1 {
-			jsonType := getJSONType(value, dataType)
-			array = append(array, jsonType)
-		} else {
-			err = jsonparser.ArrayEach(value, func(value2 []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
-				jsonType := getJSONType(value2, dataType)
-				array = append(array, jsonType)
-				return true, nil
-			})
+		if depth == 1 {
+			array = append(array, getValueType(key, value, dataType, config))
+		} else if dataType == jsonparser.Array {
+			types, _, err := getJSONArrayTypes(key, value, depth-1, config)
 			if err != nil {
 				return false, err
 			}
+			array = append(array, types...)
+		} else if dataType == jsonparser.Null {
+			array = append(array, TypeNil)
+		} else {
+			// 数组维度不一致
+			array = append(array, TypeAny)
 		}
 		// 注释只提取外层的
 		if c == "" && string(comment) != "" {
@@ -783,26 +1437,25 @@ func getJSONArrayType(data []byte, count int) (result string, c string, err erro
 		return true, nil
 	})
 	if err != nil {
-		return "", c, err
+		return nil, c, err
 	}
-	return mergeFiledType(array, true), c, nil
+	return array, c, nil
+}
+
+// 获取属性的类型，根据配置推断时间类型
+func getValueType(key string, value []byte, dataType jsonparser.ValueType, config *Config) string {
+	t := getJSONType(value, dataType)
+	if config.TimeFlag {
+		t = getTimeType(key, value, dataType, t)
+	}
+	return t
 }
 
 // 获取json属性的类型
 func getJSONType(value []byte, t jsonparser.ValueType) string {
 	str := TypeAny
 	if t == jsonparser.Number {
-		str = TypeFloat64
-		v := string(value)
-		if !strings.Contains(v, ".") {
-			// 是整数
-			i, _ := strconv.Atoi(v)
-			if i >= MinInt32 && i <= MaxInt32 {
-				str = TypeInt
-			} else {
-				str = TypeInt64
-			}
-		}
+		str = getNumberType(string(value))
 	} else if t == jsonparser.Boolean {
 		str = TypeBool
 	} else if t == jsonparser.String {
@@ -813,6 +1466,33 @@ func getJSONType(value []byte, t jsonparser.ValueType) string {
 	return str
 }
 
+// 获取数字的类型，包含小数点或者指数的是浮点数
+func getNumberType(v string) string {
+	if strings.ContainsAny(v, ".eE") {
+		return TypeFloat64
+	}
+	// 是整数
+	if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if i >= MinInt32 && i <= MaxInt32 {
+			if i < 0 {
+				return TypeNegInt
+			}
+			return TypeInt
+		}
+		if i < 0 {
+			return TypeNegInt64
+		}
+		return TypeInt64
+	}
+	if strings.HasPrefix(v, "-") {
+		return TypeNumber
+	}
+	if _, err := strconv.ParseUint(v, 10, 64); err == nil {
+		return TypeUint64
+	}
+	return TypeNumber
+}
+
 func numToLetter(s string) string {
 	switch s {
 	case "0":
@@ -839,17 +1519,46 @@ func numToLetter(s string) string {
 	return s
 }
 
-func convertInitialisms(s string) string {
-	upper := strings.ToUpper(s)
-	if _, ok := commonInitialisms[upper]; ok {
-		return upper
+// 缩写词，大写；缩写词的写法，例如 OAUTH:OAuth
+func getInitialisms(config *Config) map[string]string {
+	initialisms := make(map[string]string, len(commonInitialisms)+len(config.Initialisms))
+	for k := range commonInitialisms {
+		initialisms[k] = k
 	}
-	return s
+	for _, k := range config.Initialisms {
+		upper := strings.ToUpper(k)
+		if k == strings.ToLower(k) {
+			// 全小写的写法使用大写
+			k = upper
+		}
+		initialisms[upper] = k
+	}
+	for _, k := range config.ExcludeInitialisms {
+		delete(initialisms, strings.ToUpper(k))
+	}
+	return initialisms
 }
 
-func isObject(group string) bool {
-	if group == GroupO || group == GroupO1 || group == GroupO2 {
-		return true
+// 连续的单词组成缩写词时，使用缩写词的写法，例如 O Auth 转换为 OAuth，优先匹配最长的
+func convertInitialisms(words []string, initialisms map[string]string) string {
+	var result strings.Builder
+	for i := 0; i < len(words); {
+		j := len(words)
+		for ; j > i; j-- {
+			if e, ok := initialisms[strings.ToUpper(strings.Join(words[i:j], ""))]; ok {
+				result.WriteString(e)
+				break
+			}
+		}
+		if j == i {
+			result.WriteString(words[i])
+			j = i + 1
+		}
+		i = j
 	}
-	return false
+	return result.String()
+}
+
+func isObject(group string) bool {
+	return groupBase(group) == GroupO
 }
@@ -1,7 +1,12 @@
 package core
 
 import (
+	"fmt"
 	"json-to-go/jsonparser"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -245,7 +250,9 @@ type Obj struct {
 ]`,
 				config: &Config{},
 			},
-			want: `type AutoGenerated struct {
+			want: `type AutoGenerated []AutoGeneratedElem
+
+type AutoGeneratedElem struct {
 	ID       int      |json:"id"|
 	Name     string   |json:"name"|
 	Location Location |json:"location"|
@@ -314,6 +321,54 @@ type Location struct {
 		} |json:"b1"|
 	} |json:"a"|
 	B int |json:"b"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试嵌套结构体使用指针",
+			args: args{
+				jsonStr: `{"a": 1, "b": {"c": 1}}`,
+				config: &Config{
+					NestFlag:    true,
+					PointerFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	A int |json:"a"|
+	B *struct {
+		C int |json:"c"|
+	} |json:"b"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试嵌套结构体使用指针，根节点是数组",
+			args: args{
+				jsonStr: `[{"a": 1}]`,
+				config: &Config{
+					NestFlag:    true,
+					PointerFlag: true,
+				},
+			},
+			want: `type AutoGenerated []*struct {
+	A int |json:"a"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试嵌套的树形结构使用指针",
+			args: args{
+				jsonStr: `{"id": 1, "name": "a", "children": [{"id": 2, "name": "b", "children": []}]}`,
+				config: &Config{
+					NestFlag:      true,
+					PointerFlag:   true,
+					RecursiveFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	ID       int              |json:"id"|
+	Name     string           |json:"name"|
+	Children []*AutoGenerated |json:"children"|
 }`,
 			wantErr: false,
 		},
@@ -638,72 +693,1644 @@ type B1 struct {
 }`,
 			wantErr: false,
 		},
+		{
+			name: "生成完整的go文件",
+			args: args{
+				jsonStr: `{
+  "k1": "v1"
+}`,
+				config: &Config{
+					PackageName: "model",
+					FileHeader:  "Code generated by json-to-go. DO NOT EDIT.",
+				},
+			},
+			want: `// Code generated by json-to-go. DO NOT EDIT.
+
+package model
+
+type AutoGenerated struct {
+	K1 string |json:"k1"|
+}
+`,
+			wantErr: false,
+		},
+		{
+			name: "测试结构体名称和前缀后缀",
+			args: args{
+				jsonStr: `{
+  "address": {
+    "city": ""
+  },
+  "tags": [
+    {
+      "name": ""
+    }
+  ]
+}`,
+				config: &Config{
+					RootName:   "UserResp",
+					TypePrefix: "UserResp",
+				},
+			},
+			want: `type UserResp struct {
+	Address UserRespAddress |json:"address"|
+	Tags    []UserRespTags  |json:"tags"|
+}
+
+type UserRespAddress struct {
+	City string |json:"city"|
+}
+
+type UserRespTags struct {
+	Name string |json:"name"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试tag选项，自动添加omitempty",
+			args: args{
+				jsonStr: `{
+  "items": [
+    {
+      "id": 1,
+      "name": "a",
+      "tag": null
+    },
+    {
+      "id": 2
+    }
+  ]
+}`,
+				config: &Config{
+					Tags:          []string{"bson"},
+					TagOptions:    map[string]string{"bson": "omitempty"},
+					OmitEmptyFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Items []Items |json:"items" bson:"items,omitempty"|
+}
+
+type Items struct {
+	ID   int         |json:"id" bson:"id,omitempty"|
+	Name string      |json:"name,omitempty" bson:"name,omitempty"|
+	Tag  interface{} |json:"tag,omitempty" bson:"tag,omitempty"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试多维数组",
+			args: args{
+				jsonStr: `{
+  "polygon": [[[[116.39, 39.9], [116.4, 39.91]]]],
+  "tensor": [[[1, 2], [3]], [[4]]],
+  "objects": [[[{"a": ""}]], [[{"b": 1}]]],
+  "empty": [[[]]],
+  "items": [
+    {
+      "matrix": [[[]]]
+    },
+    {
+      "matrix": [[], [[1]]]
+    }
+  ]
+}`,
+				config: &Config{},
+			},
+			want: `type AutoGenerated struct {
+	Polygon [][][][]float64   |json:"polygon"|
+	Tensor  [][][]int         |json:"tensor"|
+	Objects [][][]Objects     |json:"objects"|
+	Empty   [][][]interface{} |json:"empty"|
+	Items   []Items           |json:"items"|
+}
+
+type Objects struct {
+	A string |json:"a"|
+	B int    |json:"b"|
+}
+
+type Items struct {
+	Matrix [][][]int |json:"matrix"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "支持入参是基础类型的数组",
+			args: args{
+				jsonStr: `[1, 2, 3]`,
+				config:  &Config{},
+			},
+			want:    `type AutoGenerated []int`,
+			wantErr: false,
+		},
+		{
+			name: "支持入参是二维数组",
+			args: args{
+				jsonStr: `[["a", 1]]`,
+				config:  &Config{},
+			},
+			want:    `type AutoGenerated [][]interface{}`,
+			wantErr: false,
+		},
+		{
+			name: "支持入参是空数组",
+			args: args{
+				jsonStr: `[]`,
+				config:  &Config{},
+			},
+			want:    `type AutoGenerated []interface{}`,
+			wantErr: false,
+		},
+		{
+			name: "入参为空",
+			args: args{
+				jsonStr: ``,
+				config:  &Config{},
+			},
+			want:    ErrEmptyJSON.Error(),
+			wantErr: true,
+		},
+		{
+			name: "测试时间识别",
+			args: args{
+				jsonStr: `{
+  "items": [
+    {
+      "created_at": "2023-01-02T15:04:05Z",
+      "birthday": "2000-01-02",
+      "update_time": 1672671845,
+      "expire_ts": 1672671845000,
+      "remark": "2023-01-02T15:04:05+08:00"
+    },
+    {
+      "created_at": "2023-01-03T15:04:05.123Z",
+      "birthday": "2000-01-03",
+      "update_time": 1672671845,
+      "expire_ts": 1672671845000,
+      "remark": "unknown"
+    }
+  ],
+  "id": 1672671845
+}`,
+				config: &Config{
+					PackageName: "model",
+					TimeFlag:    true,
+				},
+			},
+			want: `package model
+
+import (
+	"strconv"
+	"time"
+)
+
+type AutoGenerated struct {
+	Items []Items |json:"items"|
+	ID    int     |json:"id"|
+}
+
+type Items struct {
+	CreatedAt  time.Time     |json:"created_at"|
+	Birthday   Date          |json:"birthday"|
+	UpdateTime UnixTime      |json:"update_time"|
+	ExpireTs   UnixMilliTime |json:"expire_ts"|
+	Remark     string        |json:"remark"|
+}
+
+// Date 日期，格式为 2006-01-02
+type Date struct {
+	time.Time
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tt.want = strings.ReplaceAll(tt.want, "|", "`")
-			got, err := Generate(tt.args.jsonStr, tt.args.config)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("Generate() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("Generate() got = %s, want %s", got, tt.want)
-			}
-		})
+	t, err := time.Parse(|"2006-01-02"|, string(data))
+	if err != nil {
+		return err
 	}
+	d.Time = t
+	return nil
 }
 
-func Test_getJSONType(t *testing.T) {
-	type args struct {
-		value []byte
-		t     jsonparser.ValueType
+func (d Date) MarshalJSON() ([]byte, error) {
+	return []byte(d.Format(|"2006-01-02"|)), nil
+}
+
+// UnixTime 秒级时间戳
+type UnixTime struct {
+	time.Time
+}
+
+func (t *UnixTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
 	}
-	tests := []struct {
-		name string
-		args args
-		want string
-	}{
+	sec, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return err
+	}
+	t.Time = time.Unix(sec, 0)
+	return nil
+}
+
+func (t UnixTime) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+}
+
+// UnixMilliTime 毫秒级时间戳
+type UnixMilliTime struct {
+	time.Time
+}
+
+func (t *UnixMilliTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	msec, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return err
+	}
+	t.Time = time.UnixMilli(msec)
+	return nil
+}
+
+func (t UnixMilliTime) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(t.UnixMilli(), 10)), nil
+}
+`,
+			wantErr: false,
+		},
 		{
+			name: "测试根节点是时间时使用类型别名",
 			args: args{
-				value: []byte("10.5"),
-				t:     jsonparser.Number,
+				jsonStr: `"2024-01-02T00:00:00Z"`,
+				config: &Config{
+					TimeFlag: true,
+				},
 			},
-			want: TypeFloat64,
+			want:    `type AutoGenerated = time.Time`,
+			wantErr: false,
 		},
 		{
+			name: "测试枚举名称不影响时间包装类型",
 			args: args{
-				value: []byte("10.0"),
-				t:     jsonparser.Number,
+				jsonStr: `{"items": [{"date": "paid"}, {"date": "paid"}, {"date": "new"}]}`,
+				config: &Config{
+					TimeFlag: true,
+					EnumFlag: true,
+				},
 			},
-			want: TypeFloat64,
+			want: `type AutoGenerated struct {
+	Items []Items |json:"items"|
+}
+
+type Items struct {
+	Date Date |json:"date"|
+}
+
+type Date string
+
+const (
+	DatePaid Date = "paid"
+	DateNew  Date = "new"
+)`,
+			wantErr: false,
 		},
 		{
+			name: "测试结构体名称不影响时间包装类型",
 			args: args{
-				value: []byte("0.0"),
-				t:     jsonparser.Number,
+				jsonStr: `{"Date": {"x": 1}}`,
+				config: &Config{
+					TimeFlag: true,
+				},
 			},
-			want: TypeFloat64,
+			want: `type AutoGenerated struct {
+	Date Date |json:"Date"|
+}
+
+type Date struct {
+	X int |json:"x"|
+}`,
+			wantErr: false,
 		},
 		{
+			name: "测试数字类型推断",
 			args: args{
-				value: []byte("100"),
-				t:     jsonparser.Number,
+				jsonStr: `{
+  "exp": 1e10,
+  "uint": [1, 18446744073709551615],
+  "big": 18446744073709551616,
+  "negative": [-1, 18446744073709551615],
+  "negativeInt64": [-3000000000, 1],
+  "items": [
+    {
+      "v": -1
+    },
+    {
+      "v": 18446744073709551615
+    }
+  ]
+}`,
+				config: &Config{},
 			},
-			want: TypeInt,
+			want: `type AutoGenerated struct {
+	Exp           float64       |json:"exp"|
+	Uint          []uint64      |json:"uint"|
+	Big           json.Number   |json:"big"|
+	Negative      []json.Number |json:"negative"|
+	NegativeInt64 []int64       |json:"negativeInt64"|
+	Items         []Items       |json:"items"|
+}
+
+type Items struct {
+	V json.Number |json:"v"|
+}`,
+			wantErr: false,
 		},
 		{
+			name: "测试整数统一使用int64，大整数使用*big.Int",
 			args: args{
-				value: []byte("3000000000"),
-				t:     jsonparser.Number,
+				jsonStr: `{
+  "int": 1,
+  "big": 18446744073709551616
+}`,
+				config: &Config{
+					Int64Flag:  true,
+					BigIntFlag: true,
+				},
 			},
-			want: TypeInt64,
+			want: `type AutoGenerated struct {
+	Int int64    |json:"int"|
+	Big *big.Int |json:"big"|
+}`,
+			wantErr: false,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := getJSONType(tt.args.value, tt.args.t); got != tt.want {
-				t.Errorf("getJSONType() = %v, want %v", got, tt.want)
+		{
+			name: "测试根节点是大整数时使用类型别名",
+			args: args{
+				jsonStr: `99999999999999999999`,
+				config: &Config{
+					BigIntFlag: true,
+				},
+			},
+			want:    `type AutoGenerated = *big.Int`,
+			wantErr: false,
+		},
+		{
+			name: "测试根节点是超出范围的数字时使用类型别名",
+			args: args{
+				jsonStr: `99999999999999999999`,
+				config:  &Config{},
+			},
+			want:    `type AutoGenerated = json.Number`,
+			wantErr: false,
+		},
+		{
+			name: "测试自动使用指针",
+			args: args{
+				jsonStr: `{
+  "items": [
+    {
+      "id": 1,
+      "name": "a",
+      "tags": ["a"],
+      "address": {
+        "city": ""
+      },
+      "score": null,
+      "extra": null
+    },
+    {
+      "id": 2,
+      "score": 1.5
+    }
+  ]
+}`,
+				config: &Config{
+					AutoPointerFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Items []Items |json:"items"|
+}
+
+type Items struct {
+	ID      int         |json:"id"|
+	Name    *string     |json:"name"|
+	Tags    []string    |json:"tags"|
+	Address *Address    |json:"address"|
+	Score   *float64    |json:"score"|
+	Extra   interface{} |json:"extra"|
+}
+
+type Address struct {
+	City string |json:"city"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试动态key生成map",
+			args: args{
+				jsonStr: `{
+  "users": {
+    "1001": {
+      "name": "a"
+    },
+    "1002": {
+      "name": "b",
+      "age": 1
+    }
+  },
+  "scores": {
+    "2023-01-01": 1,
+    "2023-01-02": 2.5
+  },
+  "mixed": {
+    "1": 1,
+    "2": "x"
+  },
+  "dict": {
+    "a": 1,
+    "b": 2
+  }
+}`,
+				config: &Config{
+					MapFlag:  true,
+					MapPaths: []string{"$.dict"},
+				},
+			},
+			want: `type AutoGenerated struct {
+	Users  map[string]Users   |json:"users"|
+	Scores map[string]float64 |json:"scores"|
+	Mixed  Mixed              |json:"mixed"|
+	Dict   map[string]int     |json:"dict"|
+}
+
+type Users struct {
+	Name string |json:"name"|
+	Age  int    |json:"age"|
+}
+
+type Mixed struct {
+	One int    |json:"1"|
+	Two string |json:"2"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试嵌套结构的map",
+			args: args{
+				jsonStr: `{
+  "users": [
+    {
+      "1001": {
+        "name": "a"
+      }
+    }
+  ]
+}`,
+				config: &Config{
+					MapFlag:  true,
+					NestFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Users []map[string]struct {
+		Name string |json:"name"|
+	} |json:"users"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试合并结构相同的结构体",
+			args: args{
+				jsonStr: `{
+  "billing_address": {
+    "city": "",
+    "zip": ""
+  },
+  "shipping_address": {
+    "zip": "",
+    "city": ""
+  },
+  "orders": [
+    {
+      "address": {
+        "city": "",
+        "zip": "",
+        "street": ""
+      }
+    }
+  ],
+  "tags": {
+    "name": ""
+  }
+}`,
+				config: &Config{
+					DedupFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	BillingAddress  Address  |json:"billing_address"|
+	ShippingAddress Address  |json:"shipping_address"|
+	Orders          []Orders |json:"orders"|
+	Tags            Tags     |json:"tags"|
+}
+
+type Orders struct {
+	Address Address |json:"address"|
+}
+
+type Address struct {
+	City   string |json:"city"|
+	Zip    string |json:"zip"|
+	Street string |json:"street"|
+}
+
+type Tags struct {
+	Name string |json:"name"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试结构体名称重名时使用父结构体作为前缀",
+			args: args{
+				jsonStr: `{
+  "user": "",
+  "order": {
+    "items": [{"id": 1}],
+    "user": {"id": 1}
+  },
+  "cart": {
+    "items": [{"sku": ""}]
+  }
+}`,
+				config: &Config{},
+			},
+			want: `type AutoGenerated struct {
+	User  string |json:"user"|
+	Order Order  |json:"order"|
+	Cart  Cart   |json:"cart"|
+}
+
+type Order struct {
+	Items []OrderItems |json:"items"|
+	User  User         |json:"user"|
+}
+
+type OrderItems struct {
+	ID int |json:"id"|
+}
+
+type User struct {
+	ID int |json:"id"|
+}
+
+type Cart struct {
+	Items []CartItems |json:"items"|
+}
+
+type CartItems struct {
+	Sku string |json:"sku"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试自定义缩写词",
+			args: args{
+				jsonStr: `{
+  "sku_id": "",
+  "oauth_token": "",
+  "o_auth_url": "",
+  "iosVersion": "",
+  "userId": 1
+}`,
+				config: &Config{
+					Initialisms:        []string{"sku", "OAuth", "IOS"},
+					ExcludeInitialisms: []string{"id"},
+				},
+			},
+			want: `type AutoGenerated struct {
+	SKUId      string |json:"sku_id"|
+	OAuthToken string |json:"oauth_token"|
+	OAuthURL   string |json:"o_auth_url"|
+	IOSVersion string |json:"iosVersion"|
+	UserId     int    |json:"userId"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试保留原来大小写的命名策略",
+			args: args{
+				jsonStr: `{
+  "user_name": "",
+  "userId": 1,
+  "_1st": "",
+  "address": {
+    "zip_code": ""
+  }
+}`,
+				config: &Config{
+					Namer: OriginalNamer{},
+				},
+			},
+			want: `type AutoGenerated struct {
+	User_name string  |json:"user_name"|
+	UserId    int     |json:"userId"|
+	Onest     string  |json:"_1st"|
+	Address   Address |json:"address"|
+}
+
+type Address struct {
+	Zip_code string |json:"zip_code"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试自定义命名策略",
+			args: args{
+				jsonStr: `{
+  "user_name": ""
+}`,
+				config: &Config{
+					Namer: NamerFunc(func(key string, config *Config) string {
+						return "X" + DefaultNamer{}.Name(key, config)
+					}),
+				},
+			},
+			want: `type XAutoGenerated struct {
+	XUserName string |json:"user_name"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试中文使用完整拼音",
+			args: args{
+				jsonStr: `{
+  "用户名称": "",
+  "银行卡号": "",
+  "重复次数": 1,
+  "用户ID": 1
+}`,
+				config: &Config{
+					FullPinYinFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	YongHuMingCheng string |json:"用户名称"|
+	YinHangKaHao    string |json:"银行卡号"|
+	ChongFuCiShu    int    |json:"重复次数"|
+	YongHuID        int    |json:"用户ID"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试多音字使用常用读音",
+			args: args{
+				jsonStr: `{
+  "长": 1,
+  "行": 1
+}`,
+				config: &Config{
+					FullPinYinFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Chang int |json:"长"|
+	Xing  int |json:"行"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试非中文文字转写为拉丁字母",
+			args: args{
+				jsonStr: `{
+  "ユーザー": "",
+  "しゃしん": "",
+  "사용자": "",
+  "имя_пользователя": "",
+  "όνομα": "",
+  "Straße": "",
+  "café": "",
+  "ИМЯ_ПОЛЬЗОВАТЕЛЯ": "",
+  "Щука": "",
+  "ЩИ": ""
+}`,
+				config: &Config{},
+			},
+			want: `type AutoGenerated struct {
+	Yuza             string |json:"ユーザー"|
+	Shashin          string |json:"しゃしん"|
+	Sayongja         string |json:"사용자"|
+	ImyaPolzovatelya string |json:"имя_пользователя"|
+	Onoma            string |json:"όνομα"|
+	Strasse          string |json:"Straße"|
+	Cafe             string |json:"café"|
+	IMYAPOLZOVATELYA string |json:"ИМЯ_ПОЛЬЗОВАТЕЛЯ"|
+	Shchuka          string |json:"Щука"|
+	SHCHI            string |json:"ЩИ"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试术语表",
+			args: args{
+				jsonStr: `{
+  "订单号": "",
+  "用户订单号": "",
+  "订单详情": {
+    "金额": 1
+  }
+}`,
+				config: &Config{
+					Glossary: ParseGlossary("# 术语表\n订单号=OrderNo\n订单=Order\n金额=Amount"),
+				},
+			},
+			want: `type AutoGenerated struct {
+	OrderNo   string  |json:"订单号"|
+	YhOrderNo string  |json:"用户订单号"|
+	OrderXq   OrderXq |json:"订单详情"|
+}
+
+type OrderXq struct {
+	Amount int |json:"金额"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试英文术语只匹配完整的单词",
+			args: args{
+				jsonStr: `{
+  "paid": true,
+  "notes": "",
+  "id": 1,
+  "user_id": 1,
+  "no": 1,
+  "order_no": 1
+}`,
+				config: &Config{
+					Glossary: ParseGlossary("id=Identifier\nno=Number"),
+				},
+			},
+			want: `type AutoGenerated struct {
+	Paid           bool   |json:"paid"|
+	Notes          string |json:"notes"|
+	Identifier     int    |json:"id"|
+	UserIdentifier int    |json:"user_id"|
+	Number         int    |json:"no"|
+	OrderNumber    int    |json:"order_no"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试只有符号的key和需要转义的tag",
+			args: args{
+				jsonStr: `{
+  "@": 1,
+  "$$": "",
+  "": 1,
+  "مرحبا": 1,
+  "-": 1,
+  "q\"x": 1,
+  "@@": {
+    "": {
+      "w": 1
+    }
+  }
+}`,
+				config: &Config{},
+			},
+			want: `type AutoGenerated struct {
+	At           int    |json:"@"|
+	DollarDollar string |json:"$$"|
+	Field1       int    |json:""|
+	Field2       int    |json:"مرحبا"|
+	Minus        int    |json:"-,"|
+	Qx           int    |json:"q\"x"|
+	AtAt         AtAt   |json:"@@"|
+}
+
+type AtAt struct {
+	Field1 Type |json:""|
+}
+
+type Type struct {
+	W int |json:"w"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试注释中的注解",
+			args: args{
+				jsonStr: `{
+  // 创建时间
+  // @type time.Time
+  "created": "2020-01-01",
+  "order_id": 1, // @name OrderNo
+  // @omitempty
+  "remark": "",
+  "secret": "", // @skip
+  // @map
+  "users": {"a": {"x": 1}},
+  "items": [{"price": 1 /* @type float64 */}, {"price": 2}]
+}`,
+				config: &Config{
+					Comment: Comment1,
+				},
+			},
+			want: `type AutoGenerated struct {
+	// 创建时间
+	Created time.Time        |json:"created"|
+	OrderNo int              |json:"order_id"|
+	Remark  string           |json:"remark,omitempty"|
+	Users   map[string]Users |json:"users"|
+	Items   []Items          |json:"items"|
+}
+
+type Users struct {
+	X int |json:"x"|
+}
+
+type Items struct {
+	Price float64 |json:"price"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试注解指定的属性名重名和不合法",
+			args: args{
+				jsonStr: `{
+  "key": 2,
+  "id": 1, // @name Key
+  "code": 1 // @name 123
+}`,
+				config: &Config{},
+			},
+			want: `type AutoGenerated struct {
+	Key   int |json:"key"|
+	Key1  int |json:"id"|
+	One23 int |json:"code"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试根据路径修改属性",
+			args: args{
+				jsonStr: `{
+  "data": {
+    "items": [
+      {
+        "id": 1,
+        "price": 1,
+        "secret": ""
+      }
+    ],
+    "id": 2
+  }
+}`,
+				config: &Config{
+					Rules: []Rule{
+						{Path: "$.data.items[*].price", Type: "float64", TagOptions: map[string]string{"json": "string"}},
+						{Path: "$..id", Name: "Key"},
+						{Path: "$.*.items[0].secret", Skip: true},
+					},
+				},
+			},
+			want: `type AutoGenerated struct {
+	Data Data |json:"data"|
+}
+
+type Data struct {
+	Items []Items |json:"items"|
+	Key   int     |json:"id"|
+}
+
+type Items struct {
+	Key   int     |json:"id"|
+	Price float64 |json:"price,string"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试规则指定的属性名和其他属性重名",
+			args: args{
+				jsonStr: `{
+  "key": 2,
+  "id": 1,
+  "items": [{"id": 1, "Key": 2}]
+}`,
+				config: &Config{
+					Rules: []Rule{
+						{Path: "$..id", Name: "Key"},
+					},
+				},
+			},
+			want: `type AutoGenerated struct {
+	Key   int     |json:"key"|
+	Key1  int     |json:"id"|
+	Items []Items |json:"items"|
+}
+
+type Items struct {
+	Key  int |json:"id"|
+	Key1 int |json:"Key"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试识别枚举",
+			args: args{
+				jsonStr: `{
+  "orders": [
+    {"status": "paid", "id": "1"},
+    {"status": "pending", "id": "2"},
+    {"status": "paid", "id": "3"},
+    {"status": "refunded", "id": "4"}
+  ],
+  "status": {"code": 0}
+}`,
+				config: &Config{
+					EnumFlag:      true,
+					EnumValidFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Orders []Orders            |json:"orders"|
+	Status AutoGeneratedStatus |json:"status"|
+}
+
+type Orders struct {
+	Status OrdersStatus |json:"status"|
+	ID     string       |json:"id"|
+}
+
+type AutoGeneratedStatus struct {
+	Code int |json:"code"|
+}
+
+type OrdersStatus string
+
+const (
+	OrdersStatusPaid     OrdersStatus = "paid"
+	OrdersStatusPending  OrdersStatus = "pending"
+	OrdersStatusRefunded OrdersStatus = "refunded"
+)
+
+// Valid 是否是已知的值
+func (e OrdersStatus) Valid() bool {
+	switch e {
+	case OrdersStatusPaid, OrdersStatusPending, OrdersStatusRefunded:
+		return true
+	}
+	return false
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试枚举包含识别为时间的值",
+			args: args{
+				jsonStr: `[{"s": "2024-01-01"}, {"s": "x"}, {"s": "x"}, {"s": "y"}]`,
+				config: &Config{
+					TimeFlag:      true,
+					EnumFlag:      true,
+					EnumValidFlag: true,
+				},
+			},
+			want: `type AutoGenerated []AutoGeneratedElem
+
+type AutoGeneratedElem struct {
+	S S |json:"s"|
+}
+
+type S string
+
+const (
+	STwo0240101 S = "2024-01-01"
+	SX          S = "x"
+	SY          S = "y"
+)
+
+// Valid 是否是已知的值
+func (e S) Valid() bool {
+	switch e {
+	case STwo0240101, SX, SY:
+		return true
+	}
+	return false
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试枚举常量和结构体重名",
+			args: args{
+				jsonStr: `{"o": [{"status": "paid"}, {"status": "paid"}, {"status": "new"}], "status_paid": {"x": 1}}`,
+				config: &Config{
+					EnumFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	O          []O        |json:"o"|
+	StatusPaid StatusPaid |json:"status_paid"|
+}
+
+type O struct {
+	Status Status |json:"status"|
+}
+
+type StatusPaid struct {
+	X int |json:"x"|
+}
+
+type Status string
+
+const (
+	StatusPaid1 Status = "paid"
+	StatusNew   Status = "new"
+)`,
+			wantErr: false,
+		},
+		{
+			name: "测试联合类型",
+			args: args{
+				jsonStr: `{"list": [{"id": "a", "item": {"x": 1}}, {"id": 1, "item": [{"x": 2}]}]}`,
+				config: &Config{
+					UnionFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	List []List |json:"list"|
+}
+
+type List struct {
+	ID   StringOrInt |json:"id"|
+	Item ItemOrItems |json:"item"|
+}
+
+type Item struct {
+	X int |json:"x"|
+}
+
+// StringOrInt 属性在不同的样本中类型不同，按照json的类型解析
+type StringOrInt struct {
+	String *string
+	Int    *int
+}
+
+func (u StringOrInt) AsString() (string, bool) {
+	if u.String == nil {
+		var v string
+		return v, false
+	}
+	return *u.String, true
+}
+
+func (u StringOrInt) AsInt() (int, bool) {
+	if u.Int == nil {
+		var v int
+		return v, false
+	}
+	return *u.Int, true
+}
+
+func (u *StringOrInt) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	switch data[0] {
+	case '"':
+		u.String = new(string)
+		return json.Unmarshal(data, u.String)
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		u.Int = new(int)
+		return json.Unmarshal(data, u.Int)
+	}
+	return fmt.Errorf("StringOrInt: unexpected json %s", data)
+}
+
+func (u StringOrInt) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.String != nil:
+		return json.Marshal(u.String)
+	case u.Int != nil:
+		return json.Marshal(u.Int)
+	}
+	return []byte("null"), nil
+}
+
+// ItemOrItems 属性在不同的样本中类型不同，按照json的类型解析
+type ItemOrItems struct {
+	Item  *Item
+	Items []Item
+}
+
+func (u ItemOrItems) AsItem() (Item, bool) {
+	if u.Item == nil {
+		var v Item
+		return v, false
+	}
+	return *u.Item, true
+}
+
+func (u ItemOrItems) AsItems() ([]Item, bool) {
+	return u.Items, u.Items != nil
+}
+
+func (u *ItemOrItems) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	switch data[0] {
+	case '{':
+		u.Item = new(Item)
+		return json.Unmarshal(data, u.Item)
+	case '[':
+		return json.Unmarshal(data, &u.Items)
+	}
+	return fmt.Errorf("ItemOrItems: unexpected json %s", data)
+}
+
+func (u ItemOrItems) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.Item != nil:
+		return json.Marshal(u.Item)
+	case u.Items != nil:
+		return json.Marshal(u.Items)
+	}
+	return []byte("null"), nil
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试根据区分字段生成多态类型",
+			args: args{
+				jsonStr: `{"events": [{"type": "click", "x": 1}, {"type": "purchase", "amount": 9.9}]}`,
+				config: &Config{
+					DiscriminatorFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Events []Events |json:"events"|
+}
+
+type EventsClick struct {
+	Type string |json:"type"|
+	X    int    |json:"x"|
+}
+
+type EventsPurchase struct {
+	Type   string  |json:"type"|
+	Amount float64 |json:"amount"|
+}
+
+// Events 根据type的值解析为不同的类型
+type Events struct {
+	Value EventsVariant
+}
+
+// EventsVariant 是 EventsClick EventsPurchase 中的一种
+type EventsVariant interface {
+	isEventsVariant()
+}
+
+func (EventsClick) isEventsVariant() {}
+
+func (EventsPurchase) isEventsVariant() {}
+
+func (e *Events) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v struct {
+		Discriminator string |json:"type"|
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v.Discriminator {
+	case "click":
+		var value EventsClick
+		err := json.Unmarshal(data, &value)
+		e.Value = value
+		return err
+	case "purchase":
+		var value EventsPurchase
+		err := json.Unmarshal(data, &value)
+		e.Value = value
+		return err
+	}
+	return fmt.Errorf("Events: unknown type %q", v.Discriminator)
+}
+
+func (e Events) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Value)
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试可选的属性不识别为多态类型",
+			args: args{
+				jsonStr: `[{"type": "a", "x": 1}, {"type": "b", "x": 1, "y": 2}, {"type": "a", "x": 3}]`,
+				config: &Config{
+					DiscriminatorFlag: true,
+				},
+			},
+			want: `type AutoGenerated []AutoGeneratedElem
+
+type AutoGeneratedElem struct {
+	Type string |json:"type"|
+	X    int    |json:"x"|
+	Y    int    |json:"y"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试树形结构",
+			args: args{
+				jsonStr: `{"menu": {"id": 1, "name": "a", "children": [{"id": 2, "name": "b", "children": [{"id": 3, "name": "c", "children": []}]}]}}`,
+				config: &Config{
+					RecursiveFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Menu Menu |json:"menu"|
+}
+
+type Menu struct {
+	ID       int     |json:"id"|
+	Name     string  |json:"name"|
+	Children []*Menu |json:"children"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试树形结构的叶子节点为null",
+			args: args{
+				jsonStr: `{"menu": [{"id": 1, "name": "a", "children": [{"id": 2, "name": "b", "children": null}]}, {"id": 3, "name": "c", "children": null}]}`,
+				config: &Config{
+					RecursiveFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Menu []Menu |json:"menu"|
+}
+
+type Menu struct {
+	ID       int     |json:"id"|
+	Name     string  |json:"name"|
+	Children []*Menu |json:"children"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试同名的数据属性不识别为树形结构",
+			args: args{
+				jsonStr: `{"data": {"total": 1, "data": [{"id": 1}]}}`,
+				config: &Config{
+					RecursiveFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Data AutoGeneratedData |json:"data"|
+}
+
+type AutoGeneratedData struct {
+	Total int        |json:"total"|
+	Data  []DataData |json:"data"|
+}
+
+type DataData struct {
+	ID int |json:"id"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试包装结构的数据不识别为树形结构",
+			args: args{
+				jsonStr: `{"code": 0, "data": {"code": "x", "data": {"id": 1}}}`,
+				config: &Config{
+					RecursiveFlag: true,
+				},
+			},
+			want: `type AutoGenerated struct {
+	Code int               |json:"code"|
+	Data AutoGeneratedData |json:"data"|
+}
+
+type AutoGeneratedData struct {
+	Code string   |json:"code"|
+	Data DataData |json:"data"|
+}
+
+type DataData struct {
+	ID int |json:"id"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试根节点是数组时不和虚拟对象比较",
+			args: args{
+				jsonStr: `[{"AutoGenerated": [{"value": 1}], "value": 2}]`,
+				config: &Config{
+					RecursiveFlag: true,
+				},
+			},
+			want: `type AutoGenerated []AutoGeneratedElem
+
+type AutoGeneratedElem struct {
+	AutoGenerated []AutoGeneratedAutoGenerated |json:"AutoGenerated"|
+	Value         int                          |json:"value"|
+}
+
+type AutoGeneratedAutoGenerated struct {
+	Value int |json:"value"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试通用的包装结构",
+			args: args{
+				jsonStr: `{"code": 0, "msg": "", "data": {"items": [{"id": 1, "name": "a"}], "total": 1, "page": 1}}`,
+				config: &Config{
+					EnvelopeFlag: true,
+				},
+			},
+			want: `type AutoGenerated = Response[Page[Items]]
+
+type Items struct {
+	ID   int    |json:"id"|
+	Name string |json:"name"|
+}
+
+// Response 通用的包装结构，T是data的类型
+type Response[T any] struct {
+	Code int    |json:"code"|
+	Msg  string |json:"msg"|
+	Data T      |json:"data"|
+}
+
+// Page 通用的包装结构，T是items的类型
+type Page[T any] struct {
+	Items []T |json:"items"|
+	Total int |json:"total"|
+	Page  int |json:"page"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试对象和数组为null时使用指针",
+			args: args{
+				jsonStr: `[{"a": {"x": 1}, "b": [1]}, {"a": null, "b": null}]`,
+				config: &Config{
+					AutoPointerFlag: true,
+				},
+			},
+			want: `type AutoGenerated []AutoGeneratedElem
+
+type AutoGeneratedElem struct {
+	A *A    |json:"a"|
+	B []int |json:"b"|
+}
+
+type A struct {
+	X int |json:"x"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试根节点是map",
+			args: args{
+				jsonStr: `{"1001": {"name": "a"}, "1002": {"name": "b"}}`,
+				config: &Config{
+					MapFlag: true,
+				},
+			},
+			want: `type AutoGenerated map[string]AutoGeneratedElem
+
+type AutoGeneratedElem struct {
+	Name string |json:"name"|
+}`,
+			wantErr: false,
+		},
+		{
+			name: "测试根节点是map的数组",
+			args: args{
+				jsonStr: `[{"1001": {"a": 1}}, {"1002": {"a": 2}}]`,
+				config: &Config{
+					MapFlag: true,
+				},
+			},
+			want: `type AutoGenerated []map[string]AutoGeneratedElem
+
+type AutoGeneratedElem struct {
+	A int |json:"a"|
+}`,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want = strings.ReplaceAll(tt.want, "|", "`")
+			got, err := Generate(tt.args.jsonStr, tt.args.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Generate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Generate() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// 编译生成的代码，并使用生成的类型解析样本，没有go命令时跳过
+func TestGenerateUnmarshal(t *testing.T) {
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	tests := []struct {
+		name    string
+		jsonStr string
+		config  *Config
+	}{
+		{
+			name:    "测试根节点是时间",
+			jsonStr: `"2024-01-02T00:00:00Z"`,
+			config:  &Config{TimeFlag: true},
+		},
+		{
+			name:    "测试根节点是日期",
+			jsonStr: `"2024-01-02"`,
+			config:  &Config{TimeFlag: true},
+		},
+		{
+			name:    "测试根节点是秒级时间戳",
+			jsonStr: `1672671845`,
+			config:  &Config{TimeFlag: true, RootName: "UpdateTime"},
+		},
+		{
+			name:    "测试根节点是毫秒级时间戳",
+			jsonStr: `1672671845000`,
+			config:  &Config{TimeFlag: true, RootName: "UpdateTime"},
+		},
+		{
+			name:    "测试根节点是超出范围的数字",
+			jsonStr: `99999999999999999999`,
+			config:  &Config{},
+		},
+		{
+			name:    "测试根节点是大整数",
+			jsonStr: `99999999999999999999`,
+			config:  &Config{BigIntFlag: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.PackageName = "main"
+			source, err := Generate(tt.jsonStr, tt.config)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			main := fmt.Sprintf("package main\n\nimport \"encoding/json\"\n\nfunc main() {\n\tvar v %s\n\tif err := json.Unmarshal([]byte(%s), &v); err != nil {\n\t\tpanic(err)\n\t}\n}\n",
+				formatName(rootName(tt.config), tt.config), strconv.Quote(tt.jsonStr))
+			dir := t.TempDir()
+			files := map[string]string{"go.mod": "module sample\n\ngo 1.18\n", "types.go": source, "main.go": main}
+			for name, content := range files {
+				if err = os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			cmd := exec.Command(goBin, "run", ".")
+			cmd.Dir = dir
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("unmarshal error = %v\n%s\n%s", err, out, source)
+			}
+		})
+	}
+}
+
+func Test_getJSONType(t *testing.T) {
+	type args struct {
+		value []byte
+		t     jsonparser.ValueType
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			args: args{
+				value: []byte("10.5"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			args: args{
+				value: []byte("10.0"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			args: args{
+				value: []byte("0.0"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			args: args{
+				value: []byte("100"),
+				t:     jsonparser.Number,
+			},
+			want: TypeInt,
+		},
+		{
+			args: args{
+				value: []byte("3000000000"),
+				t:     jsonparser.Number,
+			},
+			want: TypeInt64,
+		},
+		{
+			args: args{
+				value: []byte("1e10"),
+				t:     jsonparser.Number,
+			},
+			want: TypeFloat64,
+		},
+		{
+			args: args{
+				value: []byte("18446744073709551615"),
+				t:     jsonparser.Number,
+			},
+			want: TypeUint64,
+		},
+		{
+			args: args{
+				value: []byte("18446744073709551616"),
+				t:     jsonparser.Number,
+			},
+			want: TypeNumber,
+		},
+		{
+			args: args{
+				value: []byte("-9223372036854775809"),
+				t:     jsonparser.Number,
+			},
+			want: TypeNumber,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getJSONType(tt.args.value, tt.args.t); got != tt.want {
+				t.Errorf("getJSONType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_formatTag(t *testing.T) {
+	type args struct {
+		key    string
+		config *Config
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			args: args{
+				key:    "name",
+				config: &Config{Tags: []string{"json", "bson"}},
+			},
+			want: "`json:\"name\" bson:\"name\"`",
+		},
+		{
+			args: args{
+				key:    "-",
+				config: &Config{Tags: []string{"json"}},
+			},
+			want: "`json:\"-,\"`",
+		},
+		{
+			args: args{
+				key:    "a`b",
+				config: &Config{Tags: []string{"json"}},
+			},
+			want: `"json:\"a` + "`" + `b\""`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatTag(tt.args.key, false, tt.args.config.TagOptions, tt.args.config.Tags); got != tt.want {
+				t.Errorf("formatTag() = %v, want %v", got, tt.want)
 			}
 		})
 	}
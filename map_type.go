@@ -0,0 +1,105 @@
+package core
+
+import (
+	"errors"
+	"json-to-go/jsonparser"
+	"regexp"
+)
+
+// 动态key，数字、日期、uuid、hash
+var dynamicKeyRegexps = []*regexp.Regexp{
+	regexp.MustCompile(`^-?\d+$`),
+	regexp.MustCompile(`^\d{4}[-/]\d{1,2}([-/]\d{1,2})?`),
+	regexp.MustCompile(`^(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`),
+	regexp.MustCompile(`^(?i)[0-9a-f]{16,}$`),
+}
+
+var errNotMap = errors.New("not map")
+
+// 判断对象是否生成map，路径匹配MapPaths，或者key都是动态的并且值的类型相同
+func isMap(path string, objs [][]byte, config *Config) bool {
+	for _, p := range config.MapPaths {
+		if matchPath(p, path) {
+			return true
+		}
+	}
+	if !config.MapFlag {
+		return false
+	}
+	count := 0
+	var groups []string
+	var types []string
+	for _, obj := range objs {
+		err := jsonparser.ObjectEach(obj, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (bool, error) {
+			count++
+			if !isDynamicKey(string(key)) {
+				return false, errNotMap
+			}
+			if dataType == jsonparser.Null {
+				return true, nil
+			}
+			group, err := getGroup(value, dataType)
+			if err != nil {
+				return false, err
+			}
+			groups = append(groups, group)
+			if group == GroupV {
+				types = append(types, getJSONType(value, dataType))
+			}
+			return true, nil
+		})
+		if err != nil {
+			return false
+		}
+	}
+	if count == 0 {
+		return false
+	}
+	if len(groups) == 0 {
+		return true
+	}
+	// 值的类型不同时会合并为any，不生成map
+	flag, group := mergeFiledGroup(groups)
+	if flag && group == GroupV {
+		return false
+	}
+	return len(types) == 0 || mergeFiledType(types, false) != TypeAny
+}
+
+func isDynamicKey(key string) bool {
+	for _, r := range dynamicKeyRegexps {
+		if r.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// 解析map的所有值，合并为一个属性
+func recursionMap(parent *Node, data []byte, config *Config) error {
+	parent.count++
+	path := elemPath(parent) + ".*"
+	return jsonparser.ObjectEach(data, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (bool, error) {
+		err := recursionValue(parent, parent.k, path, value, dataType, string(comment), config)
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+}
+
+// 属性对应的结构体，map使用值对应的结构体
+func structNode(node *Node) *Node {
+	if groupBase(node.g) == GroupM && len(*node.children) > 0 {
+		return structNode((*node.children)[0])
+	}
+	return node
+}
+
+// 是否需要结构体名称，对象和值为对象的map
+func needTypeName(node *Node) bool {
+	if groupBase(node.g) == GroupM {
+		return len(*node.children) > 0 && needTypeName((*node.children)[0])
+	}
+	return isObject(node.g)
+}
@@ -0,0 +1,169 @@
+package core
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	NamerDefault  = "default"
+	NamerOriginal = "original"
+)
+
+// Namer 属性名和结构体名称的命名策略，将json的key转换为go的标识符，重名由调用方处理
+type Namer interface {
+	Name(key string, config *Config) string
+}
+
+// NamerFunc 使用函数作为命名策略
+type NamerFunc func(key string, config *Config) string
+
+func (f NamerFunc) Name(key string, config *Config) string {
+	return f(key, config)
+}
+
+// DefaultNamer 默认的命名策略，例如 user_name userName 转换为 UserName
+type DefaultNamer struct{}
+
+// OriginalNamer 保留key原来的大小写，只去掉非法字符并且首字母大写，例如 user_name 转换为 User_name
+type OriginalNamer struct{}
+
+var namers = map[string]Namer{
+	NamerDefault:  DefaultNamer{},
+	NamerOriginal: OriginalNamer{},
+}
+
+// RegisterNamer 注册命名策略，命令行和web界面可以通过名称选择
+func RegisterNamer(name string, namer Namer) {
+	namers[name] = namer
+}
+
+// GetNamer 根据名称获取命名策略，名称为空时使用默认的命名策略
+func GetNamer(name string) (Namer, bool) {
+	if name == "" {
+		name = NamerDefault
+	}
+	namer, ok := namers[name]
+	return namer, ok
+}
+
+func getConfigNamer(config *Config) Namer {
+	if config.Namer == nil {
+		return DefaultNamer{}
+	}
+	return config.Namer
+}
+
+// Name 将驼峰式命名和下划线命名转换为单词，首字母大写，并处理缩写词
+func (DefaultNamer) Name(key string, config *Config) string {
+	var words []string
+	// 将驼峰式命名转换为下划线分割
+	newKey := convertToUnderline(key)
+	// 按下划线分割，每个片段的首字母大写
+	split := strings.Split(newKey, "_")
+	for _, str := range split {
+		span := ""
+		runes := []rune(str)
+		for j := 0; j < len(runes); j++ {
+			v := runes[j]
+			s := ""
+			if isLetter(v) {
+				s = string(v)
+			} else if isDigit(v) {
+				s = string(v)
+			} else if unicode.Is(unicode.Han, v) {
+				// 处理中文字符，连续的中文一起转换，根据词语判断多音字的读音
+				k := hanEnd(runes, j)
+				pinyin := convertToPinyin(string(runes[j:k]), config)
+				j = k - 1
+				if config.FullPinYinFlag {
+					// 完整拼音每个音节作为一个单词
+					if span != "" {
+						words = append(words, span)
+						span = ""
+					}
+					for _, p := range pinyin {
+						words = append(words, upperFirst(p))
+					}
+					continue
+				}
+				s = pinyin[0]
+			} else if isTransliterable(v) {
+				// 日文假名、韩文、西里尔字母等转写为拉丁字母
+				k := transliterateEnd(runes, j)
+				s = Transliterate(string(runes[j:k]))
+				j = k - 1
+			}
+			if s == "" {
+				continue
+			}
+			if span == "" {
+				s = upperFirst(s)
+			}
+			if len(words) == 0 && span == "" {
+				// 首字母如果是数字，就转换
+				s = numToLetter(s)
+			}
+			span += s
+		}
+		if span != "" {
+			words = append(words, span)
+		}
+	}
+	return convertInitialisms(words, getInitialisms(config))
+}
+
+// Name 保留字母、数字和下划线，中文转拼音，首字母大写
+func (OriginalNamer) Name(key string, config *Config) string {
+	var buffer strings.Builder
+	runes := []rune(key)
+	for j := 0; j < len(runes); j++ {
+		v := runes[j]
+		s := ""
+		if isLetter(v) || isDigit(v) || v == '_' && buffer.Len() > 0 {
+			s = string(v)
+		} else if unicode.Is(unicode.Han, v) {
+			k := hanEnd(runes, j)
+			pinyin := convertToPinyin(string(runes[j:k]), config)
+			j = k - 1
+			if config.FullPinYinFlag {
+				for i, p := range pinyin {
+					pinyin[i] = upperFirst(p)
+				}
+			}
+			s = strings.Join(pinyin, "")
+		} else if isTransliterable(v) {
+			k := transliterateEnd(runes, j)
+			s = Transliterate(string(runes[j:k]))
+			j = k - 1
+		}
+		if s == "" {
+			continue
+		}
+		if buffer.Len() == 0 {
+			// 首字母如果是数字，就转换
+			s = numToLetter(upperFirst(s))
+		}
+		buffer.WriteString(s)
+	}
+	return buffer.String()
+}
+
+// 连续中文的结束位置
+func hanEnd(runes []rune, start int) int {
+	end := start
+	for end < len(runes) && unicode.Is(unicode.Han, runes[end]) {
+		end++
+	}
+	return end
+}
+
+// 首字母大写
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	runes := []rune(s)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -25,6 +25,12 @@ func GetPinYin(str string) string {
 	}
 	value := dict[str]
 	if value == "" {
+		// 使用完整拼音的首字母
+		for _, v := range str {
+			if full := getFullPinYin(v); full != "" {
+				return full[:1]
+			}
+		}
 		return defaultLetter
 	}
 	return value
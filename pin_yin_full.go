@@ -0,0 +1,314 @@
+package core
+
+import (
+	"json-to-go/jsonparser"
+	"strings"
+)
+
+// 完整拼音文件来源：https://github.com/mozillazg/go-pinyin/blob/master/pinyin_dict.go
+// 去掉声调，ü使用v，每个中文只保留一个读音，常用多音字的读音由polyphoneDict确定
+//
+// go-pinyin 的许可证：
+//
+// The MIT License (MIT)
+//
+// Copyright (c) 2016 mozillazg, 闲耘 <hu.zhenyan@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+var fullDictStr = `{"a":"啊嗄錒锕阿𠼞𥥩𨉚𱚱","ai":"㕌㗒㘷㝶㢊㤅㱯㶼㾢㿄䀳䅬䑂䔽䝽䠹䨠䶣伌僾凒叆哀哎唉啀嗌嗳嘊噯埃塧壒娭娾嫒嬡愛懓懝挨捱敱敳昹暧曖欸毐溰溾濭爱瑷璦癌皑皚皧瞹矮砹硋碍礙艾蔼薆藹譪譺躷銰鎄鑀锿閡隘霭靄靉餲馤騃鱫鴱𠊎𠳳𡁍𡉓𡟓𡰽𡶃𢟪𢟰𢣏𢣕𢰇𣋞𣜬𣝅𣤃𣩱𤸖𤸳𤻢𥡽𥤦𥴨𦗍𦗐𦥂𦥈𦩴𧏹𧓁𧡋𧪚𧰿𧵨𨶂𩈋𩪂𩫇𩮖𪇈𪕭𫉁𫘤","an":"㛺㜝㞄㟁㫨㱘㸩㽢䀂䅁䅖䜙䢿䬓䮗䯥侒俺儑唵啽垵埯堓婩媕安岸峖庵按揞晻暗案桉氨洝犴玵痷盦盫罯胺腤荌菴萻葊蓭誝諳谙豻貋銨錌铵闇隌雸鞌鞍韽馣鮟鵪鶕鹌黯鿷𠉬𠰑𠽪𡎑𡪁𡪙𡯏𡹼𡽜𢰍𣆛𣚖𣣚𣵱𣽥𤃷𤜁𤞿𤟉𥏮𥦍𥳬𦺽𧖮𧩸𧫥𧫧𧮍𨲊𩅝𩈴𩓤𩭢𩹎𩽾𪁟𪘒𱏋","ang":"㭿㼜䀚䇦䒢䩕䭹䭺卬岇昂昻枊盎肮醠骯𠵫𠹃𡕉𡵙𢓋𣉗𣖮𤭒𦫫𩉰𩑝𩔘𩜟","ao":"㑃㕭㘬㘭㜜㜩㟼㠂㠗㤇㥿㩠㿰䐿䜒䥝䦋䫜䫨䮯䯠䴈䵅傲凹厫嗷嗸坳垇墺奡奥奧媪媼嫯岙岰嶅嶴廒慠懊扷抝拗摮擙敖柪梎滶澳熬爊獒獓璈磝翱翶翺聱芺蔜螯袄襖謷謸軪遨鏊鏖镺隞隩驁骜鰲鳌鷔鼇鿫𡊛𡏼𢁱𢕟𢧴𢳆𣊁𣷫𤏶𤺾𥂢𥑑𥜌𦪈𦽀𧅃𧨲𩈏𩑍𩑤𩕀𩘮𩟇𩣻𩥊𩮯𩱏𩼈𪁾𪃨𪉑𱍛𱖎","ba":"㔜㞎㭭㶚㸭㺴㿬䃻䆉䇑䎬䎱䟦䩗䩻䮂䰾䳊䶕丷仈八叐叭吧哵坝坺垻墢壩夿妭岜峇巴巼弝扒把抜拔捌朳柭欛灞炦爸犮玐疤癹矲笆粑紦罢罷羓耙胈芭茇菝蚆覇詙豝跁跋軷釛釟鈀钯霸靶颰魃魞鮊鲃鲅鲌鼥鿱𠛋𠵺𡚭𢃳𢇷𢠭𢺞𢻷𣬶𣬷𤜕𤜱𤣸𤤒𥎱𥝧𦓧𦫙𦳺𧎱𧲧𧺡𧺺𧿏𨊹𩃴𩊤𩖽𩙥𩚥𩠀𩡩𩨜𩹏𩽷𫜨","bai":"㓦㔥㗑㠔㿟䒔䙓䢙䪹䳆佰庍拜拝挀捭掰摆擘擺敗柏栢猈瓸白百稗竡粨粺絔薭襬贁败韛𠫛𡏯𡭢𢈕𢛞𣧙𣺽𤁣𤙅𤽹𥬝𦣺𦩋𦳞𨃅𩋂𩎻𩏞𪡈𫖔","ban":"㚘㪵䃑䈲䉽䬳伴办半坂坢姅岅怑扮扳拌搬攽斑斒昄板柈湴版班瓣瓪瘢癍秚粄絆绊舨般蝂螁螌褩辦辬鈑鉡钣闆阪靽頒颁魬鳻𠔯𠚼𠦒𠧫𠯘𠺚𡯘𢲔𢴬𣪂𤡰𤦦𤫫𤳖𥷁𥹓𦎊𦙹𦝤𧇥𧌿𧿨𨐦𨐱𨐾𨭉𩔮𩢔𩿉𪄕𪉒𪒋","bang":"㙃㨍㭋㮄㿶䂜䎧䖫䧛䩷䰷傍垹塝帮幇幚幫捠搒梆棒棓榜浜牓玤磅稖綁縍绑膀艕蒡蚌蜯謗谤邦邫鎊镑鞤髈𠨵𠬣𠲑𠳐𡽲𢁏𢄎𢜗𢮏𢶶𢸌𣘙𣮡𣮧𤚰𤱵𦰥𦾭𨢐𩍗𩦠𩮗𱮿𲀃","bao":"㙅㙸㫧㲒㵡㻄㿺䈏䎂䤖䥤䨌䨔䪨䭋䳈䳰䴐佨保儤勹勽包堡堢報媬嫑孢宝宲寚寳寶忁怉报抱暴曓枹煲爆珤窇笣緥胞苞菢葆蕔薄藵虣蚫袌褒褓襃豹賲趵鉋鑤铇闁雹靌靤飹飽饱駂骲髱鮑鲍鳵鴇鸨齙龅𠅬𠣒𠣺𠤏𠹕𡂟𡉩𡧖𡶄𢼌𣭀𤔣𤝧𤞥𤿈𥄹𥭓𦡕𦢊𧝘𧭤𧵢𨇅𨚔𨠖𨰦𨰻𩊅𩍂𩛞𩬽𩭼𩾡𩿓𪏶𲍹","bei":"㔨㗗㛝㣁㤳㫲㰆㶔㷶㸢㸬㸽㻗㽡㾱䋳䔒䟺䡶䥯䩀䰽俻倍偝偹備僃北卑呗唄备孛悖悲惫愂憊揹昁杯桮梖椑焙牬犕狈狽珼琲盃碑碚禙糒背苝蓓藣蛽被褙誖貝贝軰輩辈邶郥鄁鉳鋇鐾钡陂鞁鞴骳鵯鹎𠋭𠐡𠢥𡋭𢂏𢃍𢴾𢻵𣎵𣖾𣬍𣬪𤜲𤰈𤳦𤵛𤷁𤹲𤿒𤿾𥏓𥶓𦈧𦈶𦩖𦮷𦾙𧉥𧋲𧶙𧼠𩇩𩔹𩖠𩚾𪱷𱽎","ben":"㡷㤓㨧㮥㮺䬱倴坋坌奔奙捹撪本栟桳楍泍渀犇獖畚笨翉苯贲輽逩錛锛𣄏𣳰𣴞𥢊𦯀𨋒𩣺𩧼𪊜𪎝𪑖𱸛","beng":"㑟㔙㷯䋽䑫䙀䨜䨻䩬䭰䳞伻傰嘣埄埲塴奟崩嵭揼泵琣琫甏甭痭祊絣綳繃绷菶蹦迸逬鏰镚閍鞛𠜳𠡮𡎾𡡈𡶤𡾛𢆸𢉁𢐒𣂤𣨥𤙾𤡭𤫬𥀂𥖗𥛱𥞩𥦜𦂌𦅈𦝷𦺑𧑑𧚭𧩱𧻓𨆊𨓁𨕧𨸂𨹹𨻱𩂦𩊌𩑚𩗴𪔑𱤺","bi":"㓖㘠㘩㙄㠲㡀㡙㢰㢶㢸㧙㪏㪤㮿㯇㱸㳼㻫㻶㿫䀣䁹䃾䄶䉾䊧䋔䎵䏢䏶䕗䖩䘡䚜䟆䟤䠋䣥䧗䨆䩛䪐䫁䫾䬛䮠䮡䯗䵄佊佖俾偪匕吡哔啚嗶坒堛壁夶奰妣妼婢嬖嬶屄币幣幤庇庳廦弊弻弼彃彼必怭怶愊愎敝斃朼枈柀柲梐楅榌比毕毖毙毴沘湢滗滭潷濞煏熚狴獘獙珌璧畀畁畢疕疪痹痺皕睤碧禆秕笓笔筆筚箄箅箆篦篳粃粊綼縪繴罼聛腷臂舭苾荜荸萆萞蓖蓽蔽薜蜌螕袐裨襅襞襣觱詖诐豍貏貱賁贔赑跸蹕躃躄逼避邲鄙鄨鄪鉍鎞鏎鐴铋閇閉閟闭陛鞸韠飶饆馝駜驆髀髲魓鮅鰏鲾鵖鷝鷩鼊鼻𠈺𠋯𠐌𠓷𠛡𠡂𠦈𠧅𠨘𠩿𠬈𠮃𠽩𡚁𡛗𡠚𡳄𡻞𡽶𢁽𢅩𢐦𢖬𢘍𢟵𢡅𢩒𢲾𢳋𢴩𣁉𣁢𣋹𣔓𣘥𣚡𣝍𣢠𣥣𣦇𣦢𣩩𣭤𣮐𣯴𣴨𤂀𤅹𤐙𤗚𤙞𤜻𤝸𤠺𤡝𤢣𤵘𤹝𤹦𤻖𤽊𥆯𥈗𥏠𥛘𥟗𥢦𥳆𥴬𥷑𦂖𦑞𦔆𦠞𦤫𦯛𦰙𦱔𦸣𧏻𧒀𧓄𧤃𧥑𧫤𧲜𧳠𨅗𨋥𨋩𨐨𨚍𨚓𨟵𨠔𨲋𨵰𨸼𨻼𩉫𩊰𩑻𩧿𩪖𩪧𩭧𩲢𩾳𪋜𪌄𪍪𪏺𪐄𫵘𮤲𮩛𱅈","bian":"㝸㣐㦚㭓㲢㳎㳒㴜㵷㺹䁵䉸䒪䛒䟍䡢䪻便匾卞变変峅弁徧忭惼扁抃揙昪汳汴炞煸牑猵獱玣甂砭碥稨窆笾箯籩糄編緶缏编艑苄萹藊蝙褊覍變貶贬辡辧辨辩辫辮辯边辺遍邉邊釆鍽閞鞭鯾鯿鳊鴘𠐈𠑟𠓫𠪂𠭹𠯴𠷖𡈯𡬯𡬲𡬸𢩟𢭥𢴂𢻶𣈠𣝜𣩀𣪭𣸇𤀫𤀲𤄺𤺇𤻶𥍚𥣝𥣰𦇭𦉙𦟣𦽟𧩰𨖠𨖾𨚕𨧕𨩫𨳲𩩯𩰍𪉱𪏗𪓍𪖯𱖅","biang":"𰻝𰻞𱿗𲁓","biao":"㟽㠒㧼㯹㶾䁃䁭䅺䔸䙳䞄䮽俵儦墂婊幖彪摽杓标標檦淲滮瀌灬熛爂猋瘭磦穮脿膘臕蔈藨表裱褾諘謤贆錶鏢鑣镖镳颩颮颷飆飇飈飊飑飙飚驃驫骉骠髟鰾鳔𠔂𠚠𠬪𢅚𢒯𢿏𣄠𤂆𤆀𤐫𥘤𥲦𦔗𦔩𦠎𦾑𧝪𧥍𧳀𧴎𧴕𨭚𩙪𩪊𩴩𩽁","bie":"㔡㢼㿜䇷䋢䌘䏟䘷䠥䭱䳤別别咇彆徶憋瘪癟莂虌蛂蟞襒蹩鱉鳖鼈龞𠍯𡐞𡘴𡙀𡙪𡷘𢆣𢐳𢛎𢠳𣇢𣊶𤉤𤷗𤺓𤾵𥞲𥡁𧆊𧌽𧝬𧧸𧿥𨂅𨒜𩓝𩠻𩡟𩦉𩵛𩸁𪂟𪐆","bin":"㟗㯽㻞䐔䚔䧬䨈傧儐宾彬摈擯斌梹椕槟檳殡殯氞汃滨濒濱濵瀕玢瑸璸砏繽缤膑臏虨豩豳賓賔邠鑌镔霦顮髌髕髩鬂鬓鬢𠴇𡦆𡦻𡧼𢲰𣉮𣢏𣰨𥃰𧷟𧸈𨐰𨽗𩆱𩴱𪇕","bing":"㓈㨀䔊䗒䴵丙並仌仒併倂偋傡兵冫冰垪寎并幷庰怲抦掤摒昞昺柄栤棅氷炳病眪禀秉稟窉竝苪蛃誁邴鈵鉼鋲陃靐鞆鞞餅餠饼鮩𠊧𠒝𠛥𠱛𡇤𡖛𡚛𡲍𡹾𢆩𢊜𢎴𢔧𣦪𣰜𥖬𥲂𦡻𦼹𦿅𨆱𨋲𨹗𩊖𩋒𩏂𩬝𩮟𩶁𪑰","bo":"㗘㝿㞈㟑㩧㩭㪍㬍㬧㴾㶿㹀㼎㼟㼣䂍䃗䊿䌟䍸䑈䗚䙏䝛䞳䟛䢌䢪䥬䪇䪬䬪䭦䭯䮀䯋䰊䳁䵗䶈亳仢伯侼僠僰剝剥勃博卜哱啵嚗孹嶓帗帛愽懪拨挬搏撥播檗欂波浡淿渤溊煿牔犦犻狛猼玻瓝瓟癶癷盋砵碆礡礴秡箔箥簙簸糪紴缽肑胉脖膊舶艊苩菠萡葧蔔蘗袚袯袰袹襏襮譒豰跛踣蹳郣鈸鉑鉢鋍鎛鑮钵钹铂镈餑餺饽馎馛馞駁駮驋驳髆髉鮁鱍鵓鹁𠧛𠮭𠱀𠴸𠷺𠸳𠺣𡀖𡅂𡋯𡯳𡯷𢂍𢐾𢠺𢣞𢩞𢫯𢺽𣋵𣛓𣧧𣭷𣽡𤃵𤒔𤗳𤗺𤚽𤜧𤶋𤾝𤿑𥜖𥭖𥮯𥴮𥸥𥹸𦃙𦈞𦋉𦤚𦤣𦯉𦰬𦲱𦼭𦽮𧇚𧙄𧟱𧲯𨈩𨍭𨏫𨨏𨭂𩃶𩄿𩈔𩌏𩍿𩏯𩓐𩗀𩗒𩗓𩙦𩜥𩟕𩣡𩧯𩬸𩯌𩱚𩷚𩽛𪌰𪍡𪓜𪙍𪚷𬭛泊","bong":"𱿅","bu":"㘵㙛㚴㨐㳍㻉㾟䀯䊇䋠䍌䏽䑰䒀䝵䪁䪔䬏䴺不佈勏卟吥咘哺喸埗埠峬布庯廍怖悑抪捕捗晡柨步歨歩瓿篰簿荹蔀补補誧踄轐逋部郶醭鈈鈽钚钸餔餢鳪鵏鸔鿻𠘁𠚉𠜙𡡐𢁻𢇴𣱶𤚵𤣰𤸵𥃨𥑢𥣌𥪀𥳖𥹴𥻞𧉩𧻷𨋞𨛒𨴪𩅇𩊬𩊶𩏮𩏵𩢕𩣝𩯏𩶉𩷖𩺼𩻗𪇰𫐓𫗦𬷕","ca":"䃰䌨䵽嚓囃擦攃礤礸遪𤄖𥗭𥩝𨆾𨺭𪊗","cai":"㒲㥒䌽䐆䞗䟀䠕䣋䰂䴭倸偲啋埰婇寀彩才採材棌毝猜睬綵縩纔菜蔡裁財财跴踩采𡣮𢎂𤁱𤚀𤝭𤟖𤷕𦬁𧀊𧵤𨙴𨯓𩁞𩧇𪇭𮉯","can":"㛑㜗㣓㥇㦧㨻㱚㻮㽩㿊䅟䉔䏼䗝䗞䘉䙁䛹䝳䟃䣟䱗䳻傪儏参參叄叅喰嬠孱惨惭慘慙慚憯掺摻朁残殘湌澯灿燦爘璨穇篸粲薒蚕蝅蠶蠺謲飡餐驂骖黪黲𠠋𠡡𠫭𡆮𡛝𢦸𢧮𢾃𣦼𣶡𣻬𤅒𥂥𥠩𥢽𥮾𥹛𦪜𦪫𦺐𧅀𧓩𨅔𨞷𨲱𩀧𩈻𩈼𩝖𩟒𩯞𪆶𮬞","cang":"㵴㶓䅮䢢仓仺伧倉傖嵢欌沧滄濸獊舱艙苍蒼藏螥賶鑶鶬鸧𠥐𡽴𡾻𡿄𤚬𦾝𨤃𩀞𩕹𩝞𱮷","cao":"㜖㯥䄚䎭䏆䐬䒃䒑嘈嶆愺懆撡操曹曺槽漕糙肏艚艸艹草蓸螬褿襙鄵鏪騲𠀊𠹊𡮦𣈅𣉿𤒕𤡐𤵥𥕢𥲍𦋿𨎝𩞄𩠎𩫥","ce":"㥽㨲㩍䇲䈟䊂䔴侧側冊册厕厠墄廁恻惻憡拺敇测測畟笧策筞筴箣簎粣荝萗萴蓛𡍫𢿸𣌧𥠉𥬰𥰡𥳯𦔎𦣧𦵪𧵡𨶨𩒄𱲆","cei":"𤭢","cen":"㞥䅾䤁䨙䲋岑嵾梣涔笒𣡎𦊃𨁊𨥣𨱼𩅨𩅮𩻛","ceng":"㣒㬝䁬䉕噌层層嶒曽曾竲蹭驓𠟂𡃆𡪠𡾓𢅋𤛢𦠇𧲅","cha":"㛼㢉㢒㣾㤞㪯㫅㮑䁟䅊䒲䓭䕓䟕䡨䤩䶪侘偛叉嗏垞奼姹察岔嵖差扠挿插揷搽杈查槎檫汊猹疀碴秅紁肞臿艖茬茶衩詧詫诧蹅銟鍤鑔锸镲靫餷馇𠝞𠞊𠽹𡋨𡌚𡝐𡝙𡨀𡵌𢔣𢘹𢣼𢭅𣆗𣍏𣘤𣘻𣱱𤜫𤜯𤞠𤳅𤳵𤵾𤶠𥃀𥌀𥑥𥥸𥫢𥻗𦉆𦑈𦑣𦛝𦝥𦦘𦦜𦦱𦳘𧠈𧫗𧶵𨀸𨃓𨆇𨙳𨩨𨪺𨼑𩝟𩟔𩴳𪑂𪑨𪒼𪘾","chai":"㑪㳗㼮㾹䐤䓱䘍䜺侪儕喍囆拆柴犲瘥祡芆茝虿蠆袃訍豺釵钗齜𡟭𡺵𢹓𤞗𤠌𥐟𦐰𦑏𧀱𧒨𧔴𧕧𧪘𧸿𨌅𩑐𲊹","chan":"㙴㙻㚲㢆㢟㤐㦃㬄㯆㰫㶣㸥㹌㹽㺗㺥䀡䂁䊲䐮䑎䜛䠨䡲䣑䤘䤫䥀䧯䩶䪜䫮䱿䴼䵐丳产僝儃儳冁刬剗剷劖啴嘽嚵囅壥婵嬋嵼巉幝幨廛忏懴懺搀摌摲攙斺旵梴棎欃毚浐湹滻潹潺澶瀍瀺灛煘燀獑產産硟磛禅禪簅緾繟纏纒缠羼艬蒇蕆蝉蟬蟾裧襜覘觇誗諂譂讇讒谄谗躔辴辿鄽酁鉆鋋鋓鏟鑱铲镡镵閳闡阐韂顫颤饞馋骣𠁷𠋷𠐩𠑆𠑑𠑡𠣄𠹖𡍌𡎻𡖞𡖤𡝫𡮿𡶴𢁧𢌚𢥋𢱟𢷹𢺟𢽝𣃘𣔵𣤱𤗻𤚍𤪮𤮭𤯥𤴿𤸦𤼋𥊓𥭔𦆀𦈎𦝟𦢙𦸰𧈪𧐲𧓋𧕃𧠛𧥓𧨗𧬦𧴃𧾡𨄉𨇝𨇦𨊝𨔢𨩪𨪑𨬖𨮻𨲵𨳂𨵍𨷭𨼒𨽊𩖌𩝚𩟶𩥮𩮏𩽝𪏁𪏂𪏋𪏦𪓄𪖎𪗂𪙞𪚃𬊤𬳲𮣴𱐙𱟫","chang":"㙊㦂㫤䅛䗅䗉䠆䩨䮖䯴䱽仧仩伥倀倡偿僘償兏厂厰唱嘗嚐场場塲娼嫦尝常廠徜怅悵惝敞昌昶晿暢椙氅淐焻猖玚琩瑒瑺瓺甞畅畼肠腸膓苌菖萇蟐裮誯鋹鋿錩鏛锠镸閶阊韔鬯鯧鱨鲳鲿鼚𠙁𠚊𡭿𢁝𢗺𢢌𤢄𤽣𤿼𥇔𥋤𥗊𥟚𥠴𥫅𦰱𦼳𧀄𨣛𨱮𨷇𩲹𪁺𪂇𪄹𪉨𬬮𮧴𱴐","chao":"㶤㷅䎐䏚䜈䫸䫿䰫仦仯勦吵嘲巐巢巣弨怊抄晁朝樔欩漅潮炒焣焯煼牊眧窲罺耖觘訬謿超轈鄛鈔钞麨鼂鼌𠰉𡏮𡡊𡯴𡻝𡼼𢁾𣰩𤙴𤰬𥕘𥲀𥿷𦙧𦨖𦸛𦾱𧧠𨄓𨌬𨗡𨢪𨨚𨴡𩈎𩖥𩱈𩱦𪍈𪍑𪎊","che":"㒤㔭㤴㥉㨋㬚㳧㵔㾝㿭䁤䋲䒆䚢䛸䜠䞣䧪䰩伡俥偖勶唓坼屮彻徹扯掣撤撦澈烢爡瞮砗硨硩聅莗蛼車车迠頙𡷖𢇛𢊏𣨊𤊿𤕛𤖷𤗙𤥭𤹞𥯥𥿊𦈈𦓍𦛖𧙝𧼳𨀠𨹡𩂻𩎚𩒷𩗙𩴟𪎺𪠳𱰗","chen":"㕴㥲㧱㫳㴴㽸䀼䆣䐜䑣䒞䜟䞋䟢䠳䢅䢈䢻䣅䤟䫈䫖儭嗔嚫塵墋夦宸尘忱愖抻捵揨敐晨曟榇樄櫬沉煁琛疢瘎瞋硶碜磣綝縝臣茞莀莐蔯薼螴衬襯訦諃諶謓讖谌谶賝贂趁趂趻踸軙辰迧郴醦鈂鍖陈陳霃鷐麎齓齔龀𠋆𢆺𢎕𣀍𣞟𤘣𤝚𤟸𤡳𤹛𥉜𥔪𥗒𥞁𥫹𦁄𦁟𧆂𧡬𧨡𧭼𧿒𨑌𨣔𨻖𨼌𨼐𨼤𩅌𩇖𪁏𮭦","cheng":"㐼㓌㛵㞼㲂㼩䁎䄇䆑䆵䇸䕝䗀䚘䞓䟓䟫䧕䫆䮪丞乗乘侱偁僜呈城埕堘塍塖娍宬峸庱徎悜惩憆憕懲成承挰掁摚撐撑晟朾枨柽棖棦椉橕橙檉檙泟洆浾溗澂澄瀓爯牚珵珹琤畻睈瞠碀秤称程稱穪窚竀筬絾緽罉脀脭荿蛏蟶裎誠诚赪赬逞郕酲鋮鏳鏿鐣铖阷靗頳饓騁騬骋鯎𠏧𠕠𠳽𡝚𡤿𡽊𢐞𢔤𢜻𢜼𢟊𢻓𢾊𢿦𢿧𣀏𣥺𣥻𤆁𤕀𤗓𤿣𥢲𥥱𦓬𦦢𧡈𧯒𧶔𧶸𧷒𧹓𨁎𨅝𨌤𨞐𨭃𨹚𩁷𩙆𩛦𩞦𩠏𩤙𩨆𩫹𩯎𪁋𬲜𱖷𱴇","chi":"㒆㓼㔑㘜㙜㞴㞿㡿㢁㢋㢮㥡㮛㰞㱀㶴㷰㺈㽚䀸䇪䊼䑛䙙䜄䜉䜵䜻䞾䟷䠠䤲䧝䪧䮈䮻䰡䳵䶔䶵侈侙傺勅勑卶叱叺吃呎哧啻喫嗤噄坻垑墀妛媸尺岻弛彨彲彳恜恥慗憏懘抶持摛攡敕斥杘欼歭歯池湁漦灻炽烾熾瓻痓痴痸瘈瘛癡眵瞝硳竾笞筂箎篪粚絺翄翅翤翨耻肔胣胵腟茌荎蚇蚩蚳螭袲袳裭褫訵誺謘貾赤赿趍趩跮踟迟遅遟遫遲鉓鉹銐雴飭饎饬馳驰魑鴟鵄鶒鷘鸱麶黐齒齝齿𠛔𠝨𠞩𠧚𠧵𠭋𠮟𠻟𡂙𡉪𡌞𡎍𡖳𡚨𡣀𡳭𡼁𢂝𢇕𢓎𢔊𢜳𢨒𣉄𣐃𣙰𣚩𣣷𣤩𣲋𣹡𤆍𤈔𤟆𤡏𤡢𤰠𤵬𤸪𥄇𥚚𥛚𥭘𥱻𦂋𦆤𦎚𦏿𦐁𦐉𦑡𦔫𦘪𦙆𦞲𦤸𦥊𦱰𦳚𦵟𧀤𧉀𧋗𧎨𧛧𧛺𧤍𧩚𧩴𧩼𧪡𧭟𧰲𧴁𧺏𧺠𧺧𧺿𧼪𨂰𨑠𨒬𨔤𨖎𨘾𨧳𨨬𨨲𨾛𩒐𩚉𩤖𩥲𩳲𩶅𩷧𩾕𩿪𪀦𪅍𪅙𪆵𪉄𪉅𪉗𪌫𪌹𪏐𫄨𫛶𲎅","chong":"㓽㤝㧤㮔㳘㹐䂌䆔䆹䌬䖝䘪䝑䡴䳯充冲嘃埫宠寵崇崈徸忡憃憧揰摏沖浺爞珫緟罿翀舂艟茺虫蝩蟲衝褈蹖銃铳隀𠑙𠖥𠝤𠟍𡿂𢖄𢛒𢝈𢡹𢥞𣐯𣑁𥁵𥅻𥫯𥬱𥭥𦑝𦟛𧐍𧘂𧝎𧩃𧼙𧼩𨈮𨖼𨛱𨤩𨳁𨿿𩌨𩒘𩜖𩞉𩞋𩥫𩩳𩬤𩰀𪄻𪅈𪅖𪎽𪒒𱖧","chou":"㐜㤽㦞㨨㮲㵞㿧䀺䌧䌷䓓䔏䪮䲖丑丒仇侴俦偢儔吜嚋婤嬦帱幬怞惆愁懤抽搊杻杽栦椆殠燽犨犫畴疇瘳皗瞅矁稠筹篘籌紬絒綢绸臭臰菗薵裯讎讐踌躊遚酧酬醜醻雔雠魗𠌪𠜋𠝽𠷎𠹝𠼡𠾉𡕐𡕪𢣊𢭆𣀓𣕾𣪐𣫐𤘶𤳝𤳠𤽯𤾊𤾦𥃧𥄨𥏈𥡀𥦅𥬠𥰞𥲅𥵬𥺣𥻤𦡴𦭸𧃝𧮻𨀔𨖬𨡑𨡲𨤷𩋄𩌄𩽀𩾂𪇘𫼝𬑡","chu":"㔘㕏㕑㗙㙇㛀㡡㤕㾥䅳䇍䊰䎌䎝䐍䖏䙘䜴䝙䟞䟣䠂䠧䢺䦌亍俶傗储儊儲処出刍初厨嘼埱处媰岀幮廚怵憷拀搐摴敊斶杵柷椘楚楮榋樗橱橻檚櫉櫥欪歜滀滁濋犓珿琡璴畜矗础礎竌竐篨絀绌耡臅芻蒢蒭蓫蕏藸處蜍蟵褚触觸諔豖豠貙趎踀蹰躇躕鄐鉏鋤锄閦除雏雛鶵鸀黜齣齭齼𠁉𠇘𠧖𠰕𠿝𡐌𡝈𡳑𢅥𢊍𢒔𢕓𢣵𢣿𢨫𣢶𣥹𣦠𣦡𤏱𤙟𤝞𤻇𥁯𥒭𥹵𦷝𦺵𦿀𧃏𧎷𧢶𧯩𧰫𧺶𧽧𨁿𨃕𨕢𨴰𨼪𩂫𩈤𩙙𩨸𩹱𩿿𪁲𪆷𪇆𪓐𬬺𬸅𬺓𰵴𱳋𲈼","chua":"㔍䊬䫄䵵欻歘𠹐𠻦𣛕𣹶𤁫","chuai":"㪓㪜䦟䦤䦷䴝啜嘬揣搋膗膪踹𠽶𢲽𣤌𣲂𨣅","chuan":"㯌㱛㼷䁣串传傳僢剶喘圌巛川暷椽歂氚汌猭玔瑏穿篅舛舡舩船荈賗踳輲遄釧钏鶨𠛖𠯀𠾮𣀔𣛹𣧒𤜼𤮍𤰌𤶱𥃹𥬫𥲏𦎇𦎜𦺛𧍒𧑝𨂦𨘼𨩴𩂍","chuang":"㡖㼽䃥䄝䆫䎫䚒䭚傸凔刅创刱剏剙創噇幢床怆愴摐摤牀牎牕疮瘡磢窓窗窻闖闯𠏨𠞮𠳹𡆪𡻯𥈄𥎒𥡟𥲡𦔛𧜧𧢆𧬧𨜾𨧖𩃕𩞆𩪘𪁱𰃷","chui":"㝽㷃䍋䞼倕吹垂埀捶搥棰椎槌炊箠腄菙錘鎚锤陲顀龡𠄒𡍮𢏒𣇦𣟈𤙵𥙋𥞃𦉈𩌝𩗰𩭦","chun":"㖺㝄㝇㵮㸪㿤䏛䐏䓐䔚䞐䞺䡅䣨䣩䥎䦮䫃䮞䲠偆唇堾媋惷旾春暙杶椿槆橁櫄浱淳湻滣漘犉瑃睶箺純纯脣莼萅萶蒓蓴蝽蠢賰輴醇醕錞陙鯙鰆鶉鶞鹑𡉐𡗥𢾎𣌚𣌠𣘣𣚆𣮢𤘛𦎧𦚧𧇶𨉩𩨁𪂹𬭚𮝸","chuo":"㚟㪬㲋䋘䓎嚽娕娖婥婼惙戳擉歠涰磭綽繛绰腏趠踔輟辍辵辶逴酫鑡齪龊𡁇𢽸𢿭𤿫𥓑𦁶𨆬𨒢𨮸𨰆𩟫𩩟𪘛","ci":"㓨㘂㘹㞖㢀㤵㩞䂣䈘䓧䗹䛐䧳䨏䭣䯸䰍䲿䳄䳐伺佌佽偨刺刾呲垐堲嬨庛慈朿柌栨次此泚濨玼珁瓷甆疵皉磁礠祠糍絘縒茈茦茨莿薋蛓螆蠀詞词賜赐趀跐辝辞辤辭雌飺餈骴髊鮆鴜鶿鷀鹚齹𠤫𠦐𠩆𠯂𡃸𡥎𡰾𢅜𢓗𢫴𢶴𣐑𣜁𣢕𥴺𥿆𥿴𦍧𦐨𦐾𦑺𦒁𦖝𦼡𧊒𧌐𧑖𧙈𧠎𧠥𧧒𧺼𨋰𨒤𨒮𨠐𨲁𨾅𩆂𩉋𩝐𩢑𩨨𩾔𪉈𪉪𪑟𫚖𰱱𲊽","cong":"㗰㜡㞱㥖㼻䈡䉘䐋䐫䓗䕺䗓䡯䢨䳷丛从匆叢囪囱婃孮従徖從忩怱悤悰慒憁暰枞棇樅樬樷欉淙漎漗潀潨灇焧熜爜琮瑽璁瞛篵緫繱聡聦聪聰苁茐葱蓯蔥藂蟌誴謥賨賩鍯鏦騘驄骢𠂥𠕁𠙂𠢛𠤰𡅇𡟟𡦷𡵷𡹸𢃏𢊕𢐔𢔩𣃗𣊷𤄓𤧚𥍷𥎋𥡬𥮨𥵫𦇎𦇱𦗜𦝰𧐱𧓏𧝮𧩪𨂴𨍉𨑪𨑹𨒀𨡮𨦱𨱸𨲧𩬼𩯍𪻐𫓩𰷥","cou":"凑湊腠輳辏𢈾𣉅𣙘𣞜𤆑𦦅𦳿𦺀𧡣𧱪𨨯𩹀𪉮","cu":"㗤䃚䙯䛤䟟䠞䢐䣯䥄䥘促噈媨徂憱殂猝瘄瘯簇粗縬脨蔟觕誎趗踧蹙蹴蹵酢醋顣麁麄麤鼀𠑯𠛙𡄱𡘛𡝉𡞜𢄧𢈠𢪃𤗁𤛏𤠽𤿚𥅗𥪱𥷼𥻒𥾛𦈚𦟠𦠁𦯣𧆓𧺲𧼜𪓡𪓰𪕝𪚯","cuan":"㠝㸑巑撺攛櫕欑殩汆熶爨穳窜竄篡簒蹿躥鋑鑹镩𢖑𢸥𤐲𥍬𥎢𥎣𥎤𨣵𨼉","cui":"㜠㝮㯔㯜㱖㳃㵏㷪䃀䄟䆊䊫䙑䧽乼伜倅催凗啐啛墔崔嶉忰悴慛摧榱槯毳淬漼濢焠獕璀疩瘁皠磪竁粋粹紣綷縗缞翆翠脃脆脺膬膵臎萃襊趡鏙顇𠗚𠞿𠟓𠩪𢂕𢄸𢕘𢡈𢶓𣃍𣯧𣰚𣿒𣿓𤎋𤗯𤛍𥨒𥳈𥻮𥼂𥼛𥼺𦦣𧎃𧑎𧚥𧜱𧳚𧹺𧼬𧽠𨄍𨅎𨊉𨻵𨿐𩤏𮉬𱂯","cun":"䍎䞭侟刌吋存寸忖拵村澊皴竴籿膥踆邨𤿄𧚉𨀛𨙯𨚲","cuo":"㟇㭫㽨㿷䂳䑘䠡䣜䰈䱜䴾剉剒厝夎嵯嵳挫措搓撮斮棤瑳痤睉矬磋脞莝莡蒫蓌蔖虘蹉躦逪遳酂醝銼錯锉错鹺鹾𠦏𢒐𢚂𢤎𢯽𣖵𣨎𣩈𤠝𥕉𥭭𥰭𧚏𨇃𨛏𩄝𩯉𪒙𪘓","da":"㙮㜓㟷㩉㾑㿯㿴䃮䌋䐛䪚䵣亣剳匒呾咑哒嗒噠垯墶大妲怛打搭撘汏沓炟燵畗畣瘩眔笚笪答繨羍耷荅荙薘蟽褡詚跶躂达迏迖迚逹達鎉鎝鐽阘靼鞑韃龖龘𠉤𠞈𠶫𠹥𡈐𡉑𡍲𡐿𡚻𢘇𢛁𢝉𢽇𣣴𣥾𣸉𤝰𤤊𤨑𥉌𥕇𦂀𦈘𦑻𦖿𦗧𦞂𦪭𦬹𨗾𨨹𨱏𩏒𩝣𩟐𩠅𩣯𩭣𫄤𫟼","dai":"㐲㞭㯂㶡㻖䈆䒫䚞䚟䲦代侢傣叇呆呔垈埭岱帒带帯帶廗待怠懛戴曃柋歹殆瀻獃玳瑇甙簤紿緿绐艜蚮袋襶貸贷蹛軑軚軩轪迨逮霴靆骀鮘鴏黛黱𠯈𠯪𠰺𠷂𡧹𢄔𢎌𣇨𣐮𣦶𣫹𤮼𤸊𥿝𦄂𦙯𦪍𧊇𧑔𨊺𨓞𨟲𨥶𨽿𩃠𩃷𪐝𱳪","dan":"㐤㕪㗖㠆㡺㲷㴷䃫䄡䉞䐷䒟䨢䨵䩥䭛丹亶伔但僤儋刐勯匰单単啖啗啿單嘾噉嚪妉媅帎弹弾彈惮憚憺抌担掸撢撣擔旦柦殚殫氮沊泹淡澸澹狚玬瓭甔疍疸瘅癉癚眈砃禫窞箪簞紞繵耼耽聃聸胆腅膽萏蓞蛋蜑衴褝襌觛誕诞贉赕躭郸鄲霮頕饏馾駳髧鴠黕黮鿕𠆛𠆶𠇋𠈰𠹆𡖓𡦨𡵕𢅒𢉑𢋃𢎪𢑝𢻼𣅟𣇇𣋊𣛱𣱍𣲥𤁡𤢏𤲭𤺺𥄦𥐹𥨎𥱷𥲄𥲇𥳸𥳹𦅼𦋪𦻁𦽜𦽫𦾩𧀻𧂄𧡪𧭃𧴸𨢿𩄕𩅾𩈉𩈊𩏥𩕤𩩧𪆻𪒾𫎫𫢸𫫦𬘘𱆥","dang":"㼕㽆䑗䣊䣣䦒儅党凼噹圵垱壋婸宕嵣当愓挡擋攩档檔欓氹潒澢灙珰璗璫瓽當盪瞊砀碭礑筜簜簹艡荡菪蕩蘯蟷裆襠譡讜谠趤逿鐺铛闣雼黨𡇈𡇵𡗍𡢈𡰨𡾕𢠽𢡂𣂳𣃉𣗋𣺼𣻍𤔶𤗾𤢎𤣞𥢷𥤗𥯕𥸈𦗴𦼲𦿆𧅗𧑘𨎴𨝦𨷾𩟈𩼉𩽳𪇁𫽮𬍡","dao":"㠀㨶㿒䆃䊭䌦䧂倒刀刂到叨噵壔导導岛島嶋嶌嶹忉悼捣捯搗擣朷椡槝檤氘焘燾瓙盗盜祷禂禱稲稻箌纛翢翿舠艔菿衜衟蹈軇道釖陦隝隯魛鱽𠐵𠴼𡄒𢭏𣁍𣫜𣱼𤓾𤘀𤷘𤹷𥓬𥗚𥺅𦒺𦦺𦦾𦩍𧼤𨗓𨱦𩈞𩕯𩬱𩭟𱽕","de":"㝵㤫㥁㯖䙷䙸嘚得徳德恴悳惪棏淂的脦鍀锝𠮊𠵨𡋩𡭂𣌏𣮊𣮰𤷙𨁽","den":"㩐扥扽","deng":"㔁㲪䒭䔲䙞䠬䮴䳾凳噔墱嬁嶝戥朩櫈灯燈璒登瞪磴竳等簦艠覴豋蹬邓鄧鐙镫隥𡦔𢯭𢿤𣩟𤮘𤺌𤼶𤾢𦩫𧄼𧾊𨄇𨎤𨮴𨶿𩍐𩞬𩯇𪌷𪑬𪒘𪔏","di":"㓳㢩㣙㪆㫝㭽㰅㹍㼵䀿䂡䃅䊮䍕䏄䏑䐎䑭䑯䗖䢑䣌䧑䨀䨤䩘䩚䯼䴞䵠䶍仾低俤偙僀厎呧唙啇啲嘀嚁地坔坘埊埞堤墑墬奃娣媂嫡嶳帝底廸弟弤彽怟慸抵拞掋摕敌敵旳杕枤柢梊梑棣樀氐涤渧滌滴焍牴狄玓珶甋眱睇砥碲磾祶禘笛第篴籴糴締缔羝翟聜腣苖荻菂菧蒂蔋蔐蔕藡蝃螮袛覿觌觝詆諦诋谛豴趆踶蹢軧迪递逓遞遰邸釱鉪鍉鏑镝阺隄靮鞮頔馰骶髢鬄鯳鸐𠍪𠐑𠒿𠕳𠚭𠥖𠨿𠫜𠽰𡄷𡒱𡚙𡚷𡛜𡰖𡽢𢅊𢉆𢓧𢕚𣂉𣅥𣚌𣬴𣯵𣲢𤁰𤈥𤝬𤞈𤧛𤬵𤾠𥕐𥖾𥳠𥸚𥾬𥿄𦉹𦨢𦵦𧀶𧂨𧉛𧋍𧍝𧤲𧺽𨂇𨌮𨑩𨑼𨗼𨘬𨪾𨮹𩉱𩑾𩭲𩴺𩷎𪄱𬱖","dian":"㓠㝪㞟㶘㸃㼭䍄䓦佃傎典厧嚸坫垫墊壂奌奠婝婰嵮巅巓巔店惦扂掂攧敁敟椣槇槙橂橝殿淀滇澱点猠玷琔电甸瘨癜癫癲碘簟蒧蕇蜔跕踮蹎钿阽電靛顚顛颠驔點齻𠑘𠢣𠩷𠫉𠶧𡱇𡼓𢅝𢕯𢖩𢻅𣇖𣒂𣢥𣣈𣣣𣧛𣪀𣪪𤠶𤩱𤿶𥅑𥇞𥑼𥢏𥦟𥮏𥳢𥵏𦅆𦒻𦕒𦽄𧄺𧍿𧽍𨈀𩂵𩄠𩅀𩆔𩥄𩨋𩬑𪑩𪓼𪖚𭣇","diao":"㒛㓮㚋㢯㪕㹦䂏䂽䄪䉆䔙䘟䳂伄凋刁刟叼吊奝屌弔弴彫扚掉殦汈琱瘹瞗碉窎窵竨簓蓧藋虭蛁訋調调貂釣鈟銱鋽鑃钓铞铫雕雿魡鮉鯛鲷鳭鵰鼦𠄏𠚥𠚻𠤼𠥑𠶰𢁕𢄦𢆴𣩰𤕷𤭈𤱩𥁮𥮐𥲟𥾯𦄋𦨣𦰏𦶌𦸔𧅈𧘨𧘩𧜣𨰑𨸓𩀜𩈮𩋙𩾗𫼛","die":"㑙㥈㦅㦶㩸㩹㫼㬪㲲㲳㷸䏲䞇䠟䪓䫕䳀䴑叠哋喋嗲垤堞峌嵽幉恎惵戜挕揲昳曡殜氎爹牃牒瓞畳疂疉疊眣眰碟絰绖耊耋胅臷艓苵蜨蝶褋褺詄諜谍趃跌蹀迭镻鰈鲽𠅗𠆙𠗛𠗨𠠯𠲷𡅥𡇓𡖐𡱷𡹭𡺑𡼄𢎆𢲼𢶣𣈍𣛻𣡟𣧈𣨂𤖒𤗨𤚊𤴍𥈖𥉺𥑇𥶺𥷕𦁜𦄔𦈅𧍱𨄌𨈈𨐁𨓊𨭓𨳺𨴗𨸅𨻗𨾤𩋞𩻵𪀒𪑧𫶇","din":"𨈖","ding":"㝎㣔㫀㴿䦺丁仃叮啶奵定嵿帄忊椗濎玎疔盯矴碇碠磸耵聢腚萣薡虰蝊訂订酊釘鋌錠鐤钉铤锭靪頂顁顶飣饤鼎鼑𢑅𣆍𣢳𤐣𤛙𥇓𥯢𥳰𥸧𦨍𦩘𧇷𧌾𧳉𩜦𩠆𩠑𩡯𩸎𩾚𪔂","diu":"丟丢銩铥𠲍𢒝","dong":"㑈㓊㖦㚵㢥㨂㼯䂢䍶䞒䰤䳉䵔东侗倲働冬冻凍动動咚垌埬墥姛娻嬞岽峒崠崬徚恫懂戙挏昸東栋棟氡氭洞涷湩硐笗箽絧胨胴腖苳菄董蕫蝀諌迵霘駧鮗鯟鶇鶫鸫鼕鿴𠄉𢔅𢛔𢳾𣿅𤤮𤦪𤲚𤷆𥫎𥳘𦡂𧄓𧓕𧡍𧯾𧲴𧳣𧼓𧽿𨩧𨿢𩂓𩐤𩐵𩜍𩣳𩧲𩭩𪐈𪔦𬟽","dou":"㛒㞳㢄㨮㪷䄈䇺䕆䛠䬦乧兜兠吺唗唞抖斗斣枓梪橷毭浢痘窦竇篼脰荳蔸蚪豆逗郖都酘鈄閗闘阧陡餖饾鬥鬦鬪鬬鬭𠁁𠍄𠱑𠾇𡂛𡂝𡆏𡙬𡟳𢦍𣁵𣂮𣘛𣭗𤀨𤅋𤝈𤞟𤾒𥆖𥉝𥥷𥺉𦄓𦆘𧏆𧘞𧡸𧮡𧯞𧯠𧯤𧱓𨁋𨥪𨪐𨴜𨶜𨹜𩊪𩑯𩔡𩮷𩳈𪌉𪐺","du":"㓃㞘㱩㸿㾄䀾䈞䓯䙱䟻䢱䦠䩲䪅䫳䮷䲧凟剢匵厾嘟堵妒妬嬻帾度杜椟櫝殬殰毒涜渎渡瀆牍牘犊犢独獨琽瓄皾督睹碡秺笃篤肚芏荰蝳螙蠧蠹裻覩読讀讟读豄賭贕赌醏錖鍍鑟镀闍阇靯韇韣韥騳髑黩黷𠉩𠠔𠠠𠣰𡍨𡎉𡝜𡰪𢉜𢝂𢷺𢾀𢾅𣧃𣨲𣫔𣰬𤚚𤚡𤫻𤬂𤬪𤴱𤵊𤶮𥀁𥀲𥃾𥑯𥓇𥓍𥖿𥝟𥝾𥯖𥲗𥳉𥳲𦌷𦏕𦘴𦙋𦛯𦡄𦳔𦺇𦺥𧁿𧉓𧋌𧐰𧑠𧔬𧛔𧞹𧰵𧷿𧾥𨂭𨋈𨍛𨧀𨽍𩞾𩧈𩩮𩵚𪍹𪐞𬭊𮙋","duan":"㟨㫁㱭䠪偳剬塅媏断斷椴段毈煅瑖短碫端簖籪緞缎耑腶葮褍躖鍛鍴锻𠡱𢭃𢯫𢷖𣠭𥠄𥵣𦾸𧤗𧶲𨱚𨺣𩏇𩤚𩤣𱛽","dui":"㙂㟋㠚㨃㬣㳔䂙䇏䜃䨴䨺䬈䭔䯟兊兌兑垖堆塠对対對嵟怼憝憞懟濧瀩痽碓磓祋綐薱襨譈譵鐓鐜镦队陮隊頧鴭𠂤𠏮𠜑𠡒𠦗𠫨𡁨𡏩𡑈𡜥𡷋𡼻𢈹𢟋𣝉𤄛𤤷𤮩𤷎𤹵𥑵𥹲𦞱𦡷𦶏𧧆𨹅𩄮𩅆𩅥𩅲𩈁𩈜𩊭𩐌𩨽𪌤𪒛𪒡𫗰","dun":"䃦䔻䤜䪃伅吨噸囤墩墪庉惇撉撴敦楯橔沌潡炖燉犜獤盹盾砘碷礅蜳趸踲蹲蹾躉逇遁遯鈍钝頓顿驐𠎻𡆰𡼖𢬼𣎴𣗁𣚪𣞇𤟢𤭞𥂦𥫬𥫱𥭒𦪔𦰭𦼿𧝗𧿗𨔡𩔂𩞤𮪥","duo":"㖼㙍㙐㛆㛊㣞㥩㻔㻧䅜䐾䑨䒳䙃䙤䠤䤪䤻䩔䫂䯬䲊亸凙刴剁剟剫咄哆哚喥嚉嚲垛垜埵堕墮墯多夛夺奪奲尮崜嶞惰憜挅挆掇敓敚敠敪朵朶柁柮桗椯毲畓痥綞缍舵裰趓跢跥跺踱躱躲軃鈬鐸铎陊陏飿饳鮵鵽𠛫𡌭𡓉𡓷𡶲𡺇𢜬𢳽𢼠𢿎𣑧𣧷𣵺𣵻𤋨𤌃𤛛𤢕𤤸𤬾𥞛𥳔𥿰𦍦𦕰𦖋𧊱𧙤𧢵𧧇𧩧𧱫𨀟𨆅𨉡𨍏𨦃𨬍𨲉𨹃𩃒𩊜𩍜𩎫𩑒𩢎𩬻𪃒𪘉𪞝𫰂","e":"㓵㔩㖾㗁㟧㠋㣂㦍㧖㩵㮙㷈㼂䄉䆓䋪䑥䑪䕏䖸䛖䝈䞩䣞䩹䫷䱮䳗䳘䳬俄偔僫匎卾厄吪呃呝咢咹噁噩囮垩堊堮妸妿姶娥娿婀屙屵岋峉峨峩崿廅恶悪惡愕戹扼搤搹擜枙櫮歞歺涐湂珴琧痾皒睋砈砐砨硆磀礘腭苊莪萼蕚蚅蛾蝁覨訛詻誐諤譌讍讹谔豟軛軶轭迗遌遏遻鄂鈋鈪鋨鍔鑩锇锷閼阏阨阸頋頞頟額顎颚额餓餩饿騀魤魥鰐鰪鱷鳄鵈鵝鵞鶚鹅鹗齃齶𠥍𠥕𠥜𠰜𠱥𠱫𠷸𡀾𡅅𡅡𡪑𡪗𡴯𡹣𡾙𢃲𢨡𢼚𣄰𣘨𣢛𣤲𣦵𤂷𤎣𤡾𤪄𤭼𤸱𥋙𥑺𥑾𥓈𥔲𥯳𦊪𦛅𧊜𧌄𧍬𧒎𧔼𧙃𧚄𧠞𧢽𧨟𧭪𧼎𧽶𧿕𨂁𨃃𨌧𨤕𨱂𨵌𨶯𨸷𨺨𩇠𩉴𩊢𩋊𩋽𩐰𩑁𩒰𩕟𩕬𩖀𩚬𩣣𩤩𩨮𩪤𩸇𩸋𩸖𩽹𪀝𪅴𪘊𪘐𪙯𫫇𱂨𱩽","ei":"誒诶","en":"䅰䬶䭓䭡奀峎恩摁煾蒽𡟯𡵖𡷐𤇯𤫹𱴨","eng":"鞥","er":"㒃㖇㚷㛅㢽㧫䋙䋩䌺䎟䎠䎶䏪䣵䮘二佴侕儿児兒刵厼咡唲尒尓尔峏弍弐栭栮樲毦洏洱爾珥粫而耳聏胹荋薾衈袻誀貮貳贰趰輀轜迩邇鉺铒陑隭餌饵駬髵鮞鲕鴯鸸𠚧𡦕𢀪𢄽𣧹𣩚𤽓𥅡𦓓𦓔𦖢𦗼𧌣𨎪𩚪𩰴𩱊𩱓𪐰𪕔𪕨𮝵","fa":"㕹㘺㛲䂲䇅䣹乏伐佱傠发垡姂彂栰橃沷法浌灋珐琺疺発發瞂砝笩筏罚罰罸茷蕟藅醱鍅閥阀髪髮𠞵𠲎𤇰𤣹𤿓𥎰𥩱𦪑𧬋𨀳𨋺","fan":"㕨㛯㠶㤆㴀㶗㸋㺕㼝㽹䀀䀟䉊䉒䊩䋣䋦䌓䐪䒦䕰䛀䡊䣲䪛䪤䫶䭵䮳仮凡凢凣勫匥反噃墦奿婏嬎嬏帆幡忛憣払旙旛杋柉梵棥樊橎氾汎泛渢滼瀪瀿烦煩燔犯璠畈番盕矾礬笲笵範籓籵緐繁繙羳翻膰舤舧范蕃薠藩蘩蠜襎訉販贩蹯軓軬轓返釩鐇鐢钒颿飜飯飰饭鱕鷭𠆩𠒾𡁈𡗹𡜀𡤎𡶉𢇪𢐲𢗰𢶃𣔶𣳜𤄑𤄫𤬨𤭍𥃵𥅒𥢌𥸨𥹇𥻫𥼞𥿋𦊻𦜒𦨲𦪖𧀭𧁉𧉤𧊾𧍙𧢜𧦟𧶶𨆌𨙮𨟄𨠒𩡫𩧅𩨏𩨩𪖇𫔍𬙆𬳳𬸪","fang":"㑂㕫㤃㧍㯐䄱䢍䲱仿倣匚坊埅堏妨房放方旊昉昘枋汸淓牥瓬眆紡纺肪舫芳蚄訪访趽邡鈁錺钫防髣魴鰟鲂鴋鶭𣄅𥫳𨾔𩇴𩗧𩲌𩷸𪕃𲍮","fei":"㔗㥱㩌㫵㵒㹃䆏䈈䉬䑔䒈䕁䕠䚨䛍䠊䤵䨽䨾䩁䰁俷剕匪厞吠啡奜妃婓婔屝废廃廢悱扉斐昲暃曊朏杮棐榧櫠沸淝渄濷狒猆疿痱癈篚緋绯翡肥肺胇胐腓芾菲萉蕜蜚蜰蟦裶誹诽費费鐨镄陫霏靅非靟飛飝飞餥馡騑騛鯡鲱鼣𠏿𠮆𡌦𢑮𢒍𢳁𢾺𣍧𣙿𤷂𤺕𤼺𥄱𥇖𥝊𥝋𥟍𥠶𥭬𦃄𦈗𦱷𧌘𧍃𧑈𧓖𧕒𧕿𧚆𧝇𨵈𨻃𩄼𩆦𩇫𩇮𩇯𩇽𩙲𩦎𩯃𩰾𩱎𩵥𩹉𪁹𪂏𫂈𬴂","fen":"㤋㥹㬟㱵㷊㸮㿎䩿䭻䴅份偾僨兝兺分吩哛坟墳奋奮妢岎帉幩弅忿愤憤昐朆朌枌梤棻棼橨氛汾濆瀵炃焚燌燓瞓秎竕粉粪糞紛纷羒羵翂肦膹芬蒶蕡蚠蚡衯訜豮豶躮轒酚鈖鐼隫雰餴饙馚馩魵鱝鲼黂黺鼖鼢𠛸𠵮𠻫𡊄𡊅𡨖𢁤𢅯𢊱𢚅𢧝𢴢𢹔𣬩𣯻𣱦𣸣𤔟𤖘𤗸𤘝𤰪𥂙𥳡𥹻𥽒𦍏𦍪𦐈𦦑𦰛𦶚𧮱𧷐𧿚𨎾𨤘𨤚𨳣𨷒𩉵𩡷𩢈𩰟𩸂𩿈𰥛𱘮","feng":"㐽㒥㛔㜂㠦㡝㦀㵯䀱䏎䒠䙜䟪䩼丰仹俸偑僼冯凤凨凬凮唪堸夆奉妦寷封峯峰崶捀摓枫桻楓檒沣沨浲湗溄漨灃烽焨煈犎猦琒甮疯瘋盽砜碸篈綘縫缝艂葑蘴蜂蠭覂諷讽豐賵赗逢鄷酆鋒鎽鏠锋闏霻靊風飌风馮鳯鳳鴌麷𠣡𡨛𡵞𢇫𢓱𣿝𤖀𥊒𥍮𥛝𥷜𥽈𦜁𦧁𧆉𧍯𧥹𧾳𨝭𨩥𨲫𨺢𩉧𩊩𩋮𩐯𩘵𩙐𩙣𩪌𪐃","fiao":"覅","fo":"仏坲梻𧥚𧼴佛","fou":"否妚殕紑缶缹缻裦雬鴀𡜊𤊻𤽦𧉈𧊦𨛔𩂆","fu":"㓡㕊㕮㙏㚆㚕㜑㟊㠅㤔㤱㩤㪄㫙㬼㭪㲗㳇㷆㽬㾈䂤䃿䄮䋨䋹䌗䌿䍖䎔䑧䒄䒇䓏䓵䔰䕎䗄䘀䘠䝾䞜䞞䞯䞸䟔䟮䠵䡍䦣䨗䨱䩉䫍䫝䭮䭸䮛䱐䳕䴸䵾乀乶付伏伕俌俘俛俯偩傅冨冹凫刜副匐呋咈咐哹嘸坿垘垺复夫妇妋姇娐婦媍嬔孚孵富尃岪峊巿幅幞府弗弣彿復怤怫懯扶抚拂拊捬撨撫敷斧旉服枎柎柫栿桴棴椨椱榑氟泭洑浮涪滏澓炥烰焤父玞玸琈甫甶畉畐痡癁盙砆砩祓祔福禣秿稃稪竎符笰筟箙簠粰糐紨紱紼絥綍綒緮縛绂绋缚罘罦翇肤胕腐腑腹膚艀艴芙芣苻茀茯荂荴莩菔萯葍蕧虙蚥蚨蚹蛗蜅蜉蝜蝠蝮衭袝袱複褔襆襥覄覆訃詂諨讣豧負賦賻负赋赙赴趺跗踾輔輹輻辅辐邞郙郛鄜酜釜釡鈇鉘鉜鍑鍢阜阝附陚韍韨頫颫馥駙驸髴鬴鮄鮒鮲鰒鲋鳆鳧鳬鳺鴔鵩鶝麩麬麱麸黻黼𠋩𠌽𠓗𠟌𠣾𠪻𠬝𠲽𡏪𡐝𡞪𡠞𡦄𡫺𡬇𡵛𢀼𢁀𢂀𢂆𢌹𢏍𢒒𢗫𢗲𢞦𢠲𢯋𢰆𢻀𣀣𣀾𣄎𣆵𣑿𣘧𣞒𣥋𣭘𣹋𣻜𣿆𤆮𤉨𤙤𤙭𤝔𤝟𤠪𤭟𤱽𤶖𤸑𤸗𤿭𥄑𥄓𥒫𥒰𥘬𥦘𥧷𥨍𥪋𥪚𥰛𥱀𥲛𥳇𥷱𥼼𥾧𦂊𦇁𦊦𦊾𦎎𦎭𦐡𦑹𦔍𦖀𦨈𦨋𦨡𦩡𦮹𦰺𦱖𦲫𦳓𦸱𦺉𦽏𦿁𧀮𧀴𧄏𧉊𧌈𧌓𧒂𧒙𧕡𧖚𧥱𧳂𧴌𧻳𧼗𧼱𧿳𨁒𨌥𨑑𨦛𨵟𨺅𩂎𩂔𩂕𩅿𩉽𩋟𩋨𩍏𩎛𩐚𩑬𩒙𩒺𩓖𩖬𩖼𩜲𩠷𩢰𩢿𩣜𩣸𩬙𩭺𩳎𩳐𩵩𩵹𩽺𩽻𩾿𩿧𪀺𪂀𪂋𪂾𪃓𪆠𪊐𪍏𫓧𫖯𫚒𫛳𮔅𱯧","ga":"伽呷嘎嘠噶尕尜尬旮玍釓錷钆魀𠁥𡉅𡯰𡯽𡼛𲈖","gai":"㕢㧉㮣㱾䀭䏗䐩䪱䬵丐乢侅匃匄垓姟峐忋戤摡改晐杚概槩槪溉漑瓂畡盖祴絠絯荄葢蓋該该豥賅賌赅郂鈣钙阣陔隑𠌰𡒖𡧣𢅤𢍓𢻉𦫻𧊏𧯺𨞨𨮂𨱕𨱣𨸛𩕭","gan":"㓧㤌㶥㽏㿻䃭䇞䊻䤗䯎䲺䵟乹亁仠倝凎凲咁坩尲尴尶尷干幹忓感扞擀攼敢旰杆柑桿榦橄檊汵泔淦漧澉灨玕甘疳皯盰矸秆稈竿笴筸簳粓紺绀肝芉苷衦詌贑贛赣赶趕迀酐骭魐鰔鱤鳡鳱𠇵𠖫𡯋𡶑𢧀𣁖𣆙𣔼𣗲𣘠𣦖𣵼𣹟𤌹𤮽𤯌𥕵𥘏𥸡𥾍𦪧𦼮𦾮𧆐𧹳𧾲𨝌𨣝𨳼𩉐𩖦𩚵𩠁𩢨𩴁𩴵𩹸𪉿𪊄𪊇𪔆𪚬𫎬𱖇𱶡𱸜","gang":"㟠㟵㧏㭎㼚㽘䚗䴚冈冮刚剛堈堽岗岡崗戅戆掆杠棡槓港焵焹牨犅疘矼筻綱纲缸罁罓罡肛釭鋼鎠钢鿍𠵹𡇬𢭈𢰌𣗵𣦐𤭛𦋳𦱌𨟼𮣲𮭰𱮴","gao":"㚏㚖㤒㵆㾸䆁䓘勂吿告夰峼搞暠杲槀槁槔槹橰檺櫜滜皋皐睾祮祰禞稁稾稿筶篙糕縞缟羔羙膏臯菒藁藳誥诰郜鋯锆镐韟餻高髙鷎鷱鼛𡋟𡜲𡷥𡼗𢍎𢞟𣓌𣝏𣽎𤱟𥓖𥢐𥢑𦍱𦏦𦤎𦺆𧚡𧜉𧠼𧢌𨝲𩋺𩏤𩓢𩔇𩕍𩫓𪔘𱗤","ge":"㖵㗆㠷㤎㦴㭘㵧㷴䈓䐙䔅䗘䘁䛿䧄䨣䪂䪺䫦个仡佮個割匌各呄咯哥哿嗝嗰圪塥彁愅戈戓戨挌搁搿擱敋格槅櫊歌滆滒牫牱犵獦疙硌箇纥肐胳膈臵舸茖葛虼蛒袼裓觡諽謌輵轕鉻鎶铬镉閣閤阁隔革鞈鞷韐韚騔骼鬲鮯鴐鴚鴿鸽鿔𠛊𠯫𠲱𠸲𠹓𠺝𡟍𢆜𢎄𢓜𢡍𢧧𢩓𢯹𢼛𣭝𤇞𤕒𤜊𤠇𤩲𤭻𥉅𥢸𥰮𥴩𥺊𦑜𦓱𦨜𧈌𧈑𧈖𧊧𧎺𧗶𧿩𨍮𨏚𨏴𨐥𨝆𨞛𨟶𨼣𨾓𩎎𩢅𩢛𩨀𩹺𩹿𩼙𩾷𪀁𪀉𪃿𪄎𪌣𬤐𬮤𮝺𱎼𱗋𱤗","gei":"給给","gen":"㫔㮓䫀亘亙哏揯搄根艮茛跟𠄣𠛵𥃩𨒼𩒝𩓓","geng":"㪅㹴㹹㾘䋁䌄䎴䢚䱍䱎䱭䱴刯哽埂堩峺庚挭暅更梗椩浭焿畊絚綆緪縆绠羮羹耕耿莄菮賡赓郠骾鯁鲠鶊鹒𠡣𡍷𡩃𢙾𢞚𣆳𣈶𣎄𥅨𥉔𥔂𥾚𦚸𦛟𦜷𦞌𦣍𦵸𧀙𧋑𧙸𧰨𩂼𩜣𩱁𩱋𩱧𬒔𬘵","gong":"㓋㓚㔶㕬㤨㧬㫒㭟㯯㺬㼦䂬䂵䇨䍔䐵䔈䡗䢼䰸䱋䲲䳍供公共功匑匔厷唝塨宫宮工巩幊廾弓恭愩慐拱拲攻杛栱汞熕珙碽糼羾肱莻蚣觥觵貢贡躬躳輁鞏髸龏龔龚𠇒𠌕𠞖𡔕𡚑𡟫𢀜𢁠𢖷𢸁𤅐𤨶𤬳𤱨𥧂𥧡𥨐𥫋𥸲𦄜𦈩𦊫𦓳𦔸𦞗𦞨𦩼𦬘𧆷𧎡𧘏𨉫𨊧𨋑𨋝𨋷𨒱𨣂𨴛𩃙𩌌𩐣𩛘𪄌𪏠𪏢𫋐𬕂𮭥𱖭","gou":"㗕㝅㝤㡚㨌㺃㽛䃓䑦䝭䬲佝冓勾坸垢够夠姤媾岣彀搆撀构枸構沟溝煹狗玽笱篝簼緱缑耇耈耉芶苟茩蚼袧褠覯觏訽詬诟豿購购遘鈎鉤钩雊鞲韝𠛎𡖑𡗁𢄇𣕉𣕌𣙱𣫌𤖮𤚼𤠼𤫱𥉇𥧒𥬉𥴴𥿺𦎯𦎼𦩷𦱣𦵷𦽋𧃛𧲿𧵈𨩦𩄢𪃺𪚭","gu":"㒴㚉㧽㯏㼋㽽㾶䀇䀜䀦䀰䉉䍛䐨䐻䓢䜼䮩䶜估傦僱凅古呱咕唂唃啒嘏固堌夃姑嫴孤尳崓崮愲扢故柧梏棝榖榾橭毂汩沽泒淈濲瀔牯牿痼皷皼盬瞽祻稒穀笟箍箛篐糓縎罛罟羖股脵臌苽菇菰蓇薣蛄蛊蛌蠱觚詁诂谷軱軲轂轱辜逧酤鈲鈷錮钴锢雇顧顾餶馉骨鮕鯝鲴鴣鶻鸪鹄鹘鼓鼔𠑹𠷞𠻧𠽿𡗷𡷓𡽂𢝳𢡇𣀐𣖫𣦩𣦭𣨍𣨺𣪸𣫀𣱫𤅱𤚱𥂰𥐬𥠳𥮝𥵠𥿍𦈔𦊬𦋆𦍩𦎰𦙶𦺠𦾫𧆻𧇡𧟣𧣡𧬕𧳸𧵎𨠋𨪷𨬕𨱃𨵐𨸯𩙏𩲱𩴡𪇗𪕷𮝴𱐏","gua":"㒷㧓㶽䈑䏦䒷䫚䯄䯏冎刮剐剮劀卦叧啩坬寡挂掛栝歄煱瓜絓緺罣罫聒胍褂詿诖趏踻銽颪颳騧鴰鸹𠆣𠈥𠊰𠙼𠛒𠜵𠟗𠮠𠯑𠵯𡜁𣅻𤆜𥄼𥈓𥝒𦊱𧤐𧿼𨵃𩢍𩻎𪇜𮉤𮉨𱴾","guai":"㧔㾩䂯䂷䊽乖叏夬怪恠拐掴摑枴柺箉𠛕𠦬𡇸𡌪𡖪𡧩𢶒𣲾𥑋𥑰𦫳𦮃𧊅𧱾𧴚𩶦","guan":"㮡㴦䎚䏓䗆䗰䘾䙛䙮䚪䝺䤽䦎䩪䪀䲘丱倌关冠官悹悺惯慣掼摜棺樌毌泴涫潅灌爟琯瓘痯瘝癏盥矔礶祼窤筦管罆罐舘莞蒄覌観觀观貫贯躀輨遦錧鏆鑵関闗關雚館馆鰥鱞鱹鳏鳤鸛鹳𠬆𠴨𡅭𡠒𡭷𢇇𢉂𢺄𣥥𣩔𣬂𤼐𥈒𥉀𥊫𥍅𥎅𥜄𥷬𥿑𦛤𦺊𨝑𨱌𨵄𨷀𩖒𪈸𫐑𱑖𱾆","guang":"㤮㫛侊俇僙光咣垙姯广広廣撗桄欟洸灮炗炚炛烡犷獷珖胱臦臩茪輄逛銧黆𠏤𢓯𤖖𤳭𤴀𥀱𦢎𧻺𨎩𨐈𨤡𨶰𩑈𩒚𩧉𪇵𪕓","gui":"㔳㧪㨳㪈㰪㲹㸵䁛䃽䅅䈐䌆䍯䐴䝿䞈䞨䠩䣀䤥䲅䳏亀佹傀刽刿劊劌匦匭匱厬圭垝妫姽媯嫢嬀宄嶡巂帰庋庪廆归恑摫撌攰攱昋晷朹柜桂桧椝椢槶槻槼檜櫃櫷歸氿湀炔猤珪瑰璝瓌癐癸皈瞡瞶硅祪禬窐筀簂簋胿膭茥蓕蛫螝蟡袿襘規规觤詭诡貴贵跪軌轨邽郌閨闺陒鞼騩鬶鬹鬼鮭鱖鱥鲑鳜龜龟𠐽𠪑𠱓𡃩𡌲𡗤𡧭𡬂𡷺𡹙𢃯𢄊𢠿𢻂𣄜𣢪𣦦𣧎𣪕𣷾𤘧𤡱𤱺𤱾𤲉𤻿𤼮𤿡𥇳𥈸𥍁𥍨𥎛𥜏𥥠𥦣𦓯𦤇𦳛𧊄𧡫𧷱𧹑𧻜𨇙𨋡𨲿𨾚𨾴𩉝𩊛𩍨𩏐𩏡𩓠𩔆𩪁𩱻𩲡𩳝𩳧𪀗𪄯𪆳𪈥𪊧𪏤𮬝𱤑𱦈","gun":"㙥㨰㯻䃂䎾䜇䵪丨惃棍滚滾璭睔睴磙緄绲蓘蔉衮袞謴輥辊鮌鯀鲧𠃌𠞬𡈧𡘝𡻨𢃩𣮎𥕦𦓼𦠺𦫎𧬪𧸫𩨬𩩌","guo":"㕵㗻㳀㳡㶁㿆䂸䆐䙨䬎䴹呙咼啯嘓囯囶囻国圀國埚堝墎崞帼幗彉彍惈慖果椁槨淉漍濄猓瘑粿綶聝腘膕菓蔮虢蜾蝈蟈裹褁輠过過郭鈛錁鍋鐹锅餜馃馘𠋜𠜴𠩥𠿤𡇄𡓣𢃦𢅗𢐚𢝸𢧰𢸗𢹖𣁯𣂄𣽅𣽰𤂁𤮋𥁁𥂣𥄍𥆘𥕖𥜭𦄰𦗒𦘌𦛢𦬗𦸈𧒖𧖻𧤯𧥵𧭕𧭣𧰒𧾛𨉹𨭗𨽏𩉕𩋗𩟂𩪐𩫏𩰬𩰭𩻧𪂠𪆹𪈃𪋊𬇹𭚦","ha":"哈奤蛤铪𡄟𨉣","hai":"㜾㤥㧡㨟㰧㰩㱼㺔㾂䇋䠽䯐䱺亥咍嗐嗨嚡塰妎孩害氦海烸胲还還酼醢頦餀饚駭駴骇骸𠀅𠔑𠹛𡕗𡾨𢞐𢩸𢻜𣖻𣢇𣳠𤵽𥁐𥂧𥩤𥩲𦐤𦤦𦤬𦷷𧻲𧽊𧽖𨀖𨒨𨡬𨸜𩞞𩠚𩡔𩪃𩰶𩹄𬐚𱘆","han":"㑵㒈㖤㘎㘕㘚㟏㟔㢨㤷㨔㪋㮀㲦㵄㶰㸁㺖㺝㼨䈄䍐䍑䎏䎯䏷䓍䓿䕿䗙䗣䘶䛞䣻䤴䥁䧲䨡䫲䮧䶃丆佄傼兯函凾厈含哻唅喊圅垾娢嫨寒屽岾崡嵅悍憨憾捍撖撼旱晗晘暵梒歛汉汗浛浫涆涵漢澏瀚焊焓熯爳猂琀甝皔睅筨罕翰肣莟菡蔊蘫虷蚶蛿蜬蜭螒譀谽豃邗邯酣釬銲鋎鋡閈闬阚雗韓韩頇頷顄顸颔馠馯駻鬫魽鶾鼾鿰𠗴𠢇𠤮𠤾𠥴𠦊𠲒𠵸𠹄𠽦𠿑𡁀𡇜𡣔𡬖𡷛𡻡𢀵𢃗𢄜𢇞𢎘𢔈𢔔𢧦𣐺𣒷𣘞𣛴𣝽𣢅𣢟𣢺𣵷𤀉𤌐𤞶𤬯𤭙𤳉𤸕𤿧𥀐𥆡𥇌𥉰𦋣𦒅𦒝𦜆𦞞𦥖𦺦𧂃𧃙𧑚𧭻𧮰𧮳𧯘𧰪𧵊𧹣𧾔𨁄𨛎𨢈𨸗𩄙𩈣𩎒𩕠𩖺𩗤𩞿𩦊𩭥𩹑𩹼𩾝𫒶𫘣𬉧𱼞","hang":"㤚㰠䀪䂫䘕䟘䣈䦭䲳垳夯斻杭沆珩笐筕絎绗航苀蚢貥迒頏颃魧𠡊𡕧𤰟𤵻𤼍𥮕𦐄𦨵𧘃𧦑𨁈𨾒𩔋𩠾𩲋𪐦𪕇𪗜𲀟","hao":"㘪㙱㚪㝀㞻㠙㩝㬔㬶䒵䚽䝞䝥䧚䧫䪽䯫傐儫号哠嗥嘷噑嚆嚎壕好恏悎昊昦晧暤暭曍椃毜毫浩淏滈澔濠灏灝獆獋獔皓皜皞皡皥秏竓籇耗聕茠蒿薃薅薧號蚝蠔諕譹豪貉郝鄗鎬顥颢鰝𠚃𠢕𡐒𡚌𡚽𡠖𡥆𡽝𢻇𣆧𣘫𣚧𣭖𣭹𤀃𤝐𤡇𤢨𤢭𤩩𤩭𤫧𥍣𦳁𧇼𧬁𧯌𨂜𨒑𨚙𨚮𨠬𨼍𩐮𩖸𩫕𩮘𩲊𱛧𱸡𱺮","he":"㕡㗿㥺㪃㪉㬞㭱㮝㮫㰤㵑㷎㹇㿣㿥䃒䅂䏜䒩䕣䚂䞦䢔䫘䮤䳽䶅䶎何佫劾合呵咊和哬啝喝嗃嗬垎壑姀寉峆惒抲敆曷柇核楁欱毼河涸渮澕焃煂熆熇燺爀狢癋皬盇盉盍盒碋礉禾秴穒篕籺粭紇翮翯荷菏萂蚵螛蠚袔褐覈訶訸詥謞诃貈賀贺赫郃鉌鑉闔阂阖靍靎靏鞨頜颌饸魺鲄鶡鶮鶴鸖鹖鹤麧齕龁龢𠀀𠗂𠘢𠚔𠡀𠧕𠰓𠳇𠳊𠵩𠶹𠶾𠻙𡇞𡇶𡫥𢄍𢅰𢥳𢬲𣆈𣏷𣣹𣲲𣿌𤈧𤌾𤖱𥋿𥘫𥝖𥝸𥞄𥞍𥟃𥻉𥽶𦃔𦇸𦒏𦘿𦛘𦛜𦳬𦺞𦼵𦽅𧀔𧇎𧇮𧊬𧝂𧝳𧨂𧪞𧬂𧬱𧭳𧮵𧯉𨋟𨍇𨜱𨜴𨨛𨴢𩄸𩅢𩌡𩐥𩑸𩩒𩩲𩵢𪈊𪖲𪘹𬌗𰵝𱣻","hei":"㱄嘿潶黑黒𢖛𢡀𥕙𨭆𩻤𬭶","hen":"㯊䓳佷很恨拫狠痕詪鞎𦚣𬣳𱖙𱳩","heng":"㔰㶇䬖䬝䯒亨哼啈堼姮恆恒悙桁横橫涥烆胻脝蘅衡鑅鴴鵆鸻𠔲𠧿𡧦𣨉𤮏𥞧𦨾𦶙𧝒𩙯𪏓","hm":"噷","hong":"㖓㗢㢬㬴㶹䀧䃔䆖䆪䉺䎕䞑䡌䡏䧆䨎䩑䪦䫹䫺䲨仜叿吰吽呍哄嗊嚝垬妅娂宏宖弘彋揈撔晎汯泓洪浤渱渹潂澋澒灴烘焢玒玜硔硡竑竤粠紅紘紭綋红纮翃翝耾苰荭葒葓蕻薨虹訇訌讧谹谼谾軣輷轟轰鈜鉷銾鋐鍧閎閧闀闂闳霐霟鞃鬨魟鴻鸿黉黌𠐿𠪷𠲓𠳃𠹅𡇳𡵓𡺭𢂔𢗵𢘌𢝁𢦅𢬀𢼦𣽝𤂲𤃫𤄏𤟼𥈿𥏕𥓰𥔀𥕗𥥈𥥡𥰲𦁷𦏺𦐌𦐳𦑟𦑠𦒃𦕠𦕷𦶓𧈽𧊯𧋔𧐬𧮴𧾧𨋮𨌁𨌆𨎗𨢣𨥺𨹁𨾊𩐠𩒓𩒴𩒼𩓅𩕆𩕉𩖉𩗄𩗢𩘇𩘎𩙛𩰓𪈘𫚉𫟹𬭎𭱊𮣳𱖀𱛒𱛨","hou":"㖃㗋㤧㫗㬋㮢㸸㺅䂉䗔䙈䞀䞧䪷䫛䳧侯候厚后吼喉垕堠帿後洉犼猴瘊睺矦篌糇翭翵葔豞逅郈鄇鍭餱骺鮜鯸鱟鲎鲘齁𠯜𠴣𠷋𡞥𡟑𡹵𢜴𣔹𣣠𣣡𤘽𤙽𥀃𥅠𥈑𥚦𦍵𦑚𦚥𦞈𦞕𧇹𧙺𧩨𧮶𧻿𧼵𩃺𩄬𩘋𩙡𪃶𪄗𪅺𪇂𪑻𪖙𬭤𱴞","hu":"㕆㗅㦆㦌㧮㧾㨭㪶㫚㯛㳷㷤㸦㺀㺉㽇㾰䁫䇘䈸䉿䊀䊺䍓䎁䓤䕶䗂䚛䞱䠒䧼䨚䨼䩐䩴䪝䬍䭅䭌䭍䰧䴣䴯乎乕乥乯互俿冱冴匢匫呼唬唿喖嗀嘑嘝嚛囫垀壶壷壺婟媩嫭嫮寣岵帍幠弖弧忽怘怙恗惚戯戶户戸戽扈抇护搰摢斛昈昒曶枑楛楜槲槴歑汻沍沪泘浒淴湖滬滸滹瀫烀焀煳熩狐猢琥瑚瓠瓳祜笏箶簄粐糊絗綔縠胡膴芐苸萀葫蔛蔰虍虎虖虝蝴螜衚觳謼護軤轷鄠醐錿鍙鍸隺雐雽韄頀頶餬鬍魱鯱鰗鱯鳠鳸鵠鶘鶦鸌鹕鹱𠥰𠦪𠯳𠰛𠴱𡍐𡜂𡞠𡧥𡰅𡱽𡴱𡵘𡹹𡻮𡼘𢆰𢉢𢎵𢏯𢑢𢑹𢚪𢝻𢨥𢨦𢪏𢽨𣄟𣎚𣑂𣓗𣙶𣛫𣝗𣡾𣫈𣲑𣹬𤇠𤌍𤎲𤐀𤘔𤘵𤜷𤝘𤞲𤨖𤭱𤶘𤹣𤾅𥂤𥇰𥐿𥢍𥢟𥰪𥲉𥶜𥷆𥾨𦁕𦊂𦊘𦊧𦏗𦖼𦗣𦧘𦩕𦬚𦭈𦴉𦷳𦺟𧂔𧅰𧆢𧆮𧆯𧇛𧇰𧌧𧍵𧗌𧘢𧛞𧞒𧠩𧢰𧣼𧥮𧥯𧦚𧦝𧩓𧰴𧲇𧲥𧹲𧹾𧻰𧿓𧿠𨍲𨕚𨖃𨛵𨝘𨝞𨢋𨢤𨣗𨥛𨱀𨴬𩂂𩑶𩖨𩢪𩨔𩰯𩱍𩳨𩵬𩶈𩾇𩾻𪂒𪄮𪍂𪏳𪏻𪕉𪕮𪕱𪙈𫗫𭘓𱝴","hua":"㓰㕦㕲㕷㚌㟆㠏㦊㭉㳸䀨䅿䇈䋀䔢䛡䱻䴳䶤划劃化华哗嘩埖夻姡婲婳嫿嬅崋搳摦撶杹枠桦椛槬樺滑澅猾画畫畵硴磆糀繣舙花芲華蒊蕐蘤螖觟話誮諙諣譁譮话釪釫鋘錵鏵铧驊骅鷨黊𠝐𠤎𠳂𠿜𡁑𢄶𢦚𢼤𣶩𤁪𤙕𥉄𥒶𥢮𥧰𦁊𦖍𦧠𦧵𦧹𦪠𦶎𦽊𧑍𧨋𧽌𨣄𨶬𨶱𩂤𩗐𩝨𩤉𩲏𩵏𩸄𪉊𫜸𫰡𮬡𱞌𱾃","huai":"㜳㠢䃶咶坏壊壞徊怀懐懷槐櫰淮瀤耲蘹蘾褢褱踝𣟉𣩹𣸎𤜄𦏨𦧬𧱳𩌃𩟮𪊉","huan":"㕕㡲㣪㪱㬇㬊㵹㶎㹕㹖㼫㿪䀓䆠䈠䍺䒛䝠䠉䥧䦡䭴䯘䴉䴋䴟唤喚喛嚾圜奂奐嬛宦寏寰峘嵈幻患愌懽换換擐攌桓梙槵欢歓歡洹浣涣渙漶澣澴烉焕煥犿狟獾环瑍環瓛痪瘓睆糫絙綄緩繯缓缳羦肒荁萈萑藧讙豢豲貆貛轘逭郇酄鉮鍰鐶锾镮闤阛雈驩鬟鯇鯶鰀鲩鴅鵍鹮𠂄𠟼𠺐𠻍𡄤𡅱𡅻𡍦𡘍𡚊𡚜𡩂𡱌𡷗𢟿𣌓𤀣𤛚𤡟𤢁𤩽𤴯𤼢𤽅𤽕𥈉𥏇𥐓𥠅𥶍𥹚𦌦𦑛𦝝𦣴𦻃𦼉𧚁𧡩𧴊𨕹𨜌𨽧𩍡𩑖𩙽𩡧𩦘𩵄𩿊𪈩𪊥𪍺𬘫𬤰𬴐𮝹𱢡𲍗","huang":"㞷㠵㡃㤺㨪㬻㾮㿠䀮䁜䄓䅣䊗䊣䌙䍿䐠䑟䞹䪄䮲䳨偟兤凰喤堭塃墴奛媓宺崲巟幌徨怳恍惶愰慌晃晄曂朚楻榥櫎湟滉潢炾煌熀熿獚瑝璜癀皇皝皩磺穔篁篊簧縨肓艎荒葟蝗蟥衁詤諻謊谎趪遑鍠鎤鐄锽隍韹餭騜鰉鱑鳇鷬黃黄𠂸𡉚𡜋𡡄𡧽𡿰𢁹𢇟𣄙𣆖𣉪𣺬𤆴𤚝𤛥𤠛𤭉𤯷𤾑𥫼𥿪𦟮𦡽𦪗𦵽𧕸𧖬𧠬𨉁𨉤𨍧𨚳𨜔𨝴𨱑𩞩𩢯𪀞𪏍𪏒𪏙𫗮𱗢","hui":"㑰㑹㜇㞀㞧㤬㥣㧑㨤㨹㩓㩨㫎㬩㱱㷄㷇㷐㹆㻅㾯䂕䃣䅏䌇䏨䕇䖶䛛䛼䜋䜐䝅䤧䧥䩈䫭䵻会佪僡儶匯卉咴哕喙嘒噅噕嚖囘回囬圚婎媈嬒孈寭屶屷幑廻廽彗彙彚徻徽恚恛恢恵悔惠慧憓懳拻挥揮撝晖晦暉暳會楎槥橞檅檓櫘殨毀毁毇汇泋洃洄浍湏滙潓澮濊瀈灰灳烠烣烩煇燬燴獩珲璤璯痐瘣睳瞺禈秽穢篲絵繢繪绘缋翙翚翬翽芔茴荟蔧蕙薈薉藱蘳虺蚘蛔蛕蜖蟪袆褘詯詼誨諱譓譭譿讳诙诲豗賄贿輝辉迴逥鏸鐬闠阓隓隳靧頮顪颒餯鮰鰴麾𠍗𠓊𠧩𠯠𠲛𠽡𠿔𡋙𡏁𡒾𡜦𡢕𡥋𡭛𡯥𡰋𡹎𡹯𢀡𢄣𢅫𢊄𢊇𢕺𢟾𢻔𣄓𣋘𣌭𣨶𣸀𤃽𤆳𤈦𤌋𤕚𤜋𤜡𤟤𤸁𤾈𥀠𥃌𥊔𥌍𥔯𥱵𥴯𥶵𥸃𦂆𦒎𦞙𦡖𦭹𦽐𧉇𧏧𧖢𧗏𧗼𧧾𧬨𧭾𧳐𨊢𨍹𨗥𨘇𨘲𨛤𨦗𨵘𩃾𩆁𩇻𩒏𩒳𩔁𩗝𩢱𩶥𩻟𪀟𪀬𪈑𪊂𪏇𪏏𪑀𪔊𪖕𪛂𫖃𫚔𬤝灰𱣄𱳢","hun":"㑮㖧㥵㨡㮯䅙䅱䊐䎜䚠䛰䡣䧰䫟䮝䰟䴷俒倱圂堚婚忶惛慁掍昏昬梡棔殙浑涽混渾溷焝琿睧睯繉荤葷觨諢诨轋閽阍餛馄魂鼲𠉣𡇯𡨩𣇲𣝂𣣏𣣞𣨿𦃕𦞢𦟲𦡵𦵣𧠚𧣢𨂱𨋨𨏂𨡫𩅴𩇇𩏖𩧰𩽼𪌽𪑕𱗃","huo":"㓉㖪㗲㘞㦎㦜㦯㨯㩇㯉㸌㺢䁨䂄䄀䄆䄑䉟䐸䣶䦝䨥䬉䰥䱛伙佸俰剨劐吙咟嚄嚯嚿夥奯惑或捇掝攉旤曤楇檴沎活湱漷濩瀖火獲癨眓矆矐砉祸禍秮秳穫耠耯臛艧获蒦藿蠖謋豁貨货邩鈥鍃鑊钬锪镬閄霍靃騞𠙞𠯐𠵾𡄴𡓘𡪞𡯢𡿿𢃎𢋒𢛯𢝇𢞕𣄸𣉒𣤨𤁹𤆄𤊴𤏘𤐰𤬁𤻙𥇙𥊮𥒠𥙨𥝂𥽥𦑌𦒧𦞦𦨯𧆑𧤴𧯆𧯱𧵻𨐶𨘌𨙀𨷮𩆀𩞺𩟨𩟸𩪭𩭳𪒩𬴃𮬟","ji":"㑧㒫㔕㗊㗱㘍㙨㙫㚡㚻㛷㞃㞆㞛㞦㠍㠎㠱㡭㡮㤂㥍㥛㦘㦸㧀㨈㫷㭲㮨㮷㰟㲅㲺㳵㴉㴕㸄㹄㻑㻷㽺㾊㾵䀈䁒䁶䂑䇫䋟䍤䐀䐕䐚䓽䕤䗁䗗䚐䛋䛴䜞䝸䞘䟇䟌䠏䢋䢳䣢䤒䦇䨖䩯䮺䰏䲯䳭䶓䶩丌丮乩亟亼亽伋伎佶偈偮僟兾冀几击刉刏剂剞剤劑勣卙即卽及叝叽吉咭哜唧喞嗘嘰嚌圾坖垍基塈塉墼妀妓姞姫姬嫉季寂寄屐岌峜嵆嵇嵴嶯己幾庴廭彐彑彶徛忌忣急悸惎愱懻戟戢技挤掎揤撃撠擊擠敧旡既旣暨暩曁朞机极枅梞棘楫極槉槣樭機橶檕檝檵櫅殛毄汲泲洎济済湒漃漈潗激濈濟瀱焏犄犱狤玑璣畸畿疾痵瘠癠癪皀皍矶磯祭禝禨积稘稩稷稽穄穊積穖穧笄笈筓箕箿簊籍紀紒級継緝績繋繼级纪继绩缉罽羁羇羈耤耭肌脊膌臮艥芨芰茍茤荠葪蒺蓟蔇蕀蕺薊薺藉蘎蘮蘻虀虮螏蟣裚襀襋覉覊覬觊觙觭計記誋諅譏譤计讥记诘谻賫賷赍趌跡跻跽踖蹐蹟躋躤躸輯轚辑迹郆鄿銈銡錤鍓鏶鐖鑇鑙际際隮集雞雦雧霁霵霽鞿韲飢饑饥驥骥髻鬾魕魢鯚鰶鰿鱀鱭鱾鲚鲫鳮鵋鶏鶺鷄鷑鸄鸡鹡麂齌齎齏齑𠀷𠋻𠍃𠑃𠓞𠔋𠗏𠚽𠟣𠦫𠨕𠨠𠫷𠮯𠯉𠱨𠲹𠴩𠴫𠶻𠷌𠹋𠼻𠿉𠿠𡁪𡁰𡃃𡅺𡇟𡋚𡜱𡥞𡦊𡦪𡪱𡫀𡬄𡳮𡹪𡽉𡿙𢁂𢃺𢆻𢉗𢍇𢏞𢓄𢗂𢗹𢚁𢜭𢡴𢨐𢩦𢭄𢰒𢱣𢺼𢼋𢼷𣄯𣄱𣇳𣏡𣔽𣖷𣛔𣜇𣣝𣪠𣬠𣯅𣰈𣱗𣳃𣹜𣽍𤊵𤋭𤌿𤎗𤓑𤛄𤜝𤜾𤠎𤤋𤫝𤳎𤵀𤷉𤺷𤿠𥈂𥊬𥋥𥒡𥕂𥖙𥘌𥝌𥠋𥡒𥡴𥣩𥨿𥪫𥪼𥫶𥭋𥭌𥭜𥰦𥳏𥷙𥺵𥾊𦁳𦂑𦆡𦇧𦋋𦌗𦌰𦎢𦜸𦝖𦠄𦠾𦩧𦪱𦮯𦮼𦳌𦵾𦶍𦺩𦺬𦺴𦺶𦼷𦾲𦿓𧃞𧇯𧉆𧉍𧎿𧐐𧓓𧗒𧟜𧡉𧡯𧢾𧤏𧥄𧧃𧧟𧧩𧩦𧪇𧪠𧫜𧫠𧮭𧽑𧾽𧾾𨀶𨂢𨄐𨅤𨊻𨋉𨍺𨐆𨒴𨛉𨛑𨜒𨢵𨣧𨤹𨦮𨪏𨮺𨲪𨳋𨳻𨸚𨹶𨻕𩀖𩉜𩉢𩐆𩓮𩚮𩜆𩞊𩠨𩥉𩦤𩧱𩨒𩩛𩯋𩴃𩼄𩼚𪂍𪂺𪄵𪄸𪅹𪊆𪌍𪔋𪘥𪟝𫌀𫓯𫓹𬯀𬶨𬶭𱏅","jia":"㕅㚙㪴㮖㹢㿓䀫䂟䑝䕒䕛䛟䩡䴥乫价佳假傢價加叚唊嘉圿埉夹夾婽嫁家岬幏徦忦恝戛戞扴抸拁斚斝架枷梜椵榎榢槚檟毠泇浃浹犌猳玾珈甲痂瘕稼笳糘耞胛腵茄荚莢葭蛱蛺袈袷裌豭貑賈贾跏跲迦郏郟鉀鉫鉿鋏鎵钾铗镓頬頰颊餄駕驾鴶鵊麚鿼𠷉𠺢𡊠𡩚𡭘𡶥𢉤𢜿𢫢𢱈𢱌𣦉𣪇𣮫𤖰𤗜𤟚𤠙𥇗𥋣𥑐𥑔𥝿𥞵𥡮𥹌𦎮𦎱𦙺𦣯𦧮𦨦𦸘𦺧𦽤𧉪𧦤𧿵𨒇𨔗𨔣𩉡𩊏𩌍𩚲𩛩𩠃𩨹𩲣𩶛𪆲𪇷𪈟𪐓𪔟𬂩","jian":"㓺㔋㔓㡨㣤㦰㨴㨵㭴㯺㰄㳨㵎㶕䄯䅐䇟䉍䌑䌠䓸䔐䘋䚊䟅䟰䤔䥜䧖䬻䭈䭠䮿䯡䵡䵤䶠䶢䶬件俭俴倹健僭儉兼冿减剑剣剪剱劍劎劒劔劗囏囝坚堅堿墹奸姦姧寋尖幵建弿彅徤惤戋戔戩戬拣挸捡揀揃搛撿擶旔暕枧柬栫梘检検椷椾楗榗樫橺檢櫼歼殱殲毽洊涧渐減湔湕溅漸澗濺瀐瀳瀸瀽煎熞熸牋牮犍猏玪珔瑊瑐监監睑睷瞷瞼硷碊碱磵礀礆礛笕笺筧简箋箭篯簡籛糋絸緘縑繝繭缄缣翦肩腱臶舰艦艰艱茧荐菅菺葌葥蒹蔪蕑蕳薦藆虃螹蠒袸裥襇襉襺見覵覸见詃諓諫謇謭譼譾谏谫豜豣賎賤贱趝趼践踐踺蹇轞釼鉴鋻鍳鍵鏩鐗鐧鐱鑑鑒鑬鑯鑳锏键間间鞬鞯韀韉餞餰饯馢鬋鰎鰹鲣鳒鳽鵳鶼鹣鹸鹻鹼麉𠊒𠍚𠏇𠐻𠫘𠹟𠼤𠽱𠿏𡄑𡄓𡅶𡑯𡬵𡭭𡾰𢃬𢆞𢆦𢍫𢐆𢦺𢨿𢩀𢳚𢵈𣘖𣘷𣚙𣜭𣝕𣠷𣥞𣮏𣳲𣴓𣽖𣽦𤀩𤄒𤍖𤧣𤪋𤷃𥀹𥊇𥌈𥍀𥍹𥡝𥢇𥯦𥳒𥳟𥳷𥴱𥽐𦁲𦂇𦋰𦏔𦢣𦣨𦩵𦺍𦺘𦽇𦾶𧀇𧀵𧂂𧂆𧂢𧅆𧗸𧙧𧢖𧤨𧥈𧬫𧮈𧲨𨎫𨏊𨔥𨢑𨣇𨤄𨪅𨰓𨳡𨳿𨴾𨵭𨷓𩅼𩆷𩇏𩉍𩉔𩋋𩌯𩍎𩛧𩟗𩱃𩻘𩽜𪃛𪆿𪉦𪋁𪏊𪐻𪒫𪒹𪙨𬑗𬘖𬣡𭄛𰀢𰯲𱖲𲍯","jiang":"㢡㯍㹔䁰䉃䋌䒂䗵䜫䞪䥒傋僵勥匞匠壃夅奖奨奬姜将將嵹弜弶彊摪摾桨槳橿櫤殭江洚浆滰漿犟獎畕畺疅疆礓糡糨絳繮绛缰翞耩膙茳葁蒋蔣薑螀螿袶講謽讲豇酱醤醬降韁顜鱂鳉𠘌𠼢𡏞𡑶𡲣𡷍𢘸𢪇𣚦𣨣𣩴𣫳𤕭𤕯𤖛𤛜𥆅𥔣𥗪𥞜𥬮𥷃𦦗𧘍𨃇𨜰𨯞𩌾𩝽𩴒𩷄𩷭𪀘𫮬𱴘𱽿","jiao":"㠐㩰㬭㭂㰾㲬㳅㶀㽱㽲䀊䂃䌭䍊䘨䚩䢒䥞䴔䶰䶷交佼侥僥僬儌剿劋叫呌嘂嘄嘦噍噭姣娇嬌嬓孂峤峧嶕嶠嶣徺徼恔憍憿挍挢捁搅摷撟撹攪敎教敫敽敿斠晈暞曒椒櫵浇湫湬滘漖潐澆灚烄焦煍燋燞狡獥珓璬皎皦皭矫矯礁穚窌窖笅簥絞繳纐绞缴胶脚腳膠膲臫艽芁茭茮蕉藠虠蛟蟜蟭角訆譑譥賋趭跤踋較轇轎轿较郊酵醮釂鉸鐎铰隦餃饺驕骄鮫鱎鲛鵁鵤鷦鷮鹪𠕧𠘣𠜅𠝑𠞰𠩏𡏭𡓖𡙎𡟠𡥈𡬋𢀌𢄺𢅎𢒾𢕪𢥚𢧱𢯴𢻟𢼫𣁹𣏑𣝞𣧦𣩓𣺳𤃭𤉧𤕝𤫷𤶀𤶳𥂨𥃤𥃪𥄉𥅟𥇟𥉒𥉼𥏹𥘊𥡤𥦢𥳴𥹜𦅃𦌆𦗵𦫶𦮁𧂈𧎙𧎸𧣦𧺜𧾐𨇕𨎦𨎬𨓩𨖵𨝰𨡃𨨴𨱓𨲭𨶟𨶪𨶲𨸋𨺹𩊔𩎔𩯘𩱞𩴧𩵰𩿑𪁉𪖄𪚰𫐖","jie":"㑘㓗㔚㘶㛃㝏㞯㠹㦢㨗㨩㫸㮞㮮㸅㼪㾏㿍䀷䀹䂝䂶䃈䅥䇒䌖䕙䕸䗻䛺䣠䥛䦈䯰䰺䱄䲙䲸丯介借倢偼傑刦刧刼劫劼卩卪吤喈喼嗟堦堺姐婕媎媘媫嫅孑尐屆届岊岕崨嵥嶻巀幯庎徣悈戒截拮捷接掲掶揭擑擮昅杢杰桀桝椄楐楬楶榤檞櫭毑洁湝滐潔煯犗玠琾界畍疌疖疥痎癤皆睫砎碣礍秸稭竭節結絜结羯脻节芥莭菨蓵蚧蛶蜐蝍蝔蠘蠞蠽街衱衸袺褯解觧訐詰誡誱謯讦诫踕迼鉣鍻鎅阶階鞂鞊颉飷骱魝魪鮚鲒鶛𠂈𠄍𠅂𠎿𠐉𠓢𠙤𠬮𠯙𠷟𡉷𡔣𡗦𡗲𡙣𡣯𡨲𡩣𡵒𡵚𡸎𡽱𢈻𢎔𢎡𢢂𢨜𢪍𢫐𢬱𢱄𢷿𢻮𣙴𣚃𣬫𣮌𣮍𣰞𣳟𣶏𤁢𤘦𤙩𤭧𤮌𥁂𥅴𥇒𥓐𥝔𥝥𥠹𥢻𥵞𥷫𥾌𦀖𦁉𦈜𦈰𦎒𦝨𦵴𦺢𦿐𧍠𧍩𧏥𧜅𧞝𧞩𧞬𧣋𧫑𧼨𧽄𧽟𧾢𧾯𨃲𨐑𨓰𨕽𨥂𨵠𩔄𩘅𩟦𩡺𩢴𩧦𩧵𩩰𩯰𩾶𪀾𪁍𪃈𪅸𪇲𪉋𪉚𪌧𪑹𪖋𪙏𬭴𮔂","jin":"㝻㦗㧆㨷㬐㬜㯲㯸㱈㴆㶦㶳㹏㻱䀆䃡䆮䈥䈽䋮䌍䌝䑤䒺䗯䘳䝲䤐䤺䥆䫴䭙䶖仅今伒侭僅僸儘兓凚劤劲勁卺厪唫噤嚍埐堇堻墐壗妗嫤嬧寖尽嶜巹巾廑惍搢斤晉晋枃槿歏殣津浕浸溍漌濅濜烬煡燼珒琎琻瑨瑾璡璶盡矜矝砛祲禁筋紟紧緊縉缙荕荩菫蓳藎衿襟覲觐觔謹谨賮贐赆近进進金釒釿錦钅锦靳饉馑鹶黅齽𠂟𠞱𠞾𠢱𠢵𠬶𠰇𠾤𠾬𡢳𡺽𢉅𢎭𢙿𢦊𢬬𢬶𢱷𢽖𣓏𣝌𤄼𤘡𤣶𤧫𤵞𥂵𥖜𥧲𥯑𦈟𦎷𦘔𦞬𦧈𦩏𦽔𧔷𧗁𨆃𨚡𨭺𩀿𩖗𩤿𪉢𪏴𪑙𪖼𫄛𬬱","jing":"㘫㢣㣏㬌䔔䜘䝼䪫䴖䵞丼井京亰俓倞傹儆兢净凈刭剄坓坕坙境妌婙婛婧宑巠幜弪弳径徑惊憬憼敬旌旍景晶暻曔桱梷橸汫汬泾浄涇淨瀞燝猄獍璄璟璥痉痙睛秔稉穽竞竟竧竫競竸粳精経經经聙肼胫脛腈茎荆荊莖菁葏蟼誩警踁迳逕鏡镜阱靓靖静靚靜頚頸颈驚鯨鲸鵛鶁鶄麖麠鼱𠑱𠗊𠗌𠦋𠭉𠭗𠲮𠳬𠷐𡁔𢀖𢈴𢹘𣋢𣬙𣻒𤜰𤰳𤷦𥅸𥠛𥯙𥶹𦀇𦂠𦜳𦥍𦳲𦽁𦾿𧑊𧓔𧤵𨙷𨥙𨵼𩃋𩇕𩓞𩓨𩰹𩳯𩹢𩻱𪂴𪇒","jiong":"㓏㢠㤯㯋㷗㷡䌹䢛侰僒冂冋冏囧坰埛扃泂浻澃炅炯烱煚煛熲燛窘絅綗蘏蘔褧迥逈颎駉駫𠕕𠖷𢂶𢄗𣔲𣕄𤌇𦀝𧍮𨴀𩓺𩚱𪔃𪕍𬳶","jiu":"㝌㠇㡱㩆㲃㸨㺩㺵䅢䆒䆶䊆䊘䛮䡂䬨䰗䳎丩久乆九乣倃僦勼匓匛匶厩咎啾奺就廄廏廐慦捄揂揪揫摎救旧朻杦柩柾桕樛欍殧汣灸牞玖疚究糺糾紤纠臼舅舊舏萛赳酒镹阄韭韮鬏鬮鯦鳩鷲鸠鹫麔齨𠃖𠃺𠕴𠖬𠙔𠚨𠛩𠜃𠜉𠠳𠣿𠴄𠿈𡆥𡚮𢀙𢑇𢜥𢽭𣁭𣐤𣟼𣲄𤉥𤴥𤴦𤴪𤷑𥆷𥘦𥠃𥤳𦠢𦭺𦭻𦱠𦱱𦱲𦽬𧡑𧫾𧾻𨖏𨘂𨘮𨳊𨾉𨾞𩏶𩏷𩒦𩢹𩭓𩱼𩶧","ju":"㖩㘌㘲㜘㞐㞫㠪㡹㥌㨿㩀㩴㪺㬬㮂㹼㽤䀠䃊䄔䅓䅕䈮䋰䎤䏱䕮䗇䛯䜯䝻䡞䢸䢹䣰䤎䪕䪶䰬䱟䱡䳔䴗䵕䶙举乬侷俱倨倶僪具冣凥剧劇勮匊句咀啹埧埾壉姖娵婅婮寠局居屦屨岠崌巈巨巪弆怇怐怚惧愳懅懼抅拒拘拠挙挶据掬據擧昛桔梮椇椈椐榉榘橘檋櫸欅歫毩毱沮泃泦洰涺淗湨澽炬烥焗爠犋犑狊狙琚疽痀眗矩砠秬窭窶筥簴粔粷罝耟聚聥腒舉艍苣苴莒菊菹蒟蘜虡蚷蜛袓裾襷詎諊讵豦貗趄趜跔跙距跼踘踞踽蹫躆躹輂遽邭郹醵鉅鋦鋸鐻钜锔锯閰陱雎鞠鞫颶飓駏駒駶驧驹鮈鮔鴡鵙鵴鶋鶪鼰鼳齟龃𠉧𠋬𠙆𠚵𠜹𠟰𠤄𠨭𠮑𠰾𡉎𡒍𡕖𡢒𡥶𡨅𡨢𡫬𡱾𡳘𡶋𡸘𡸨𡿾𢚆𢤫𢩁𢯺𣌬𣍇𣎛𣖣𣶝𣻐𤔋𤖵𤜔𤢓𤷢𤼳𥂃𥄷𥇛𥈋𥉁𥘮𥢧𥪏𥬙𥮗𥯔𥲜𥴧𥴪𥷚𦀣𦅽𦇙𦊐𦗻𦙮𦛓𦜛𦞇𦟳𦥑𦱅𦺖𦼈𧂜𧄛𧝲𧣒𧣻𧤑𧲋𧵞𧷾𧸧𧹕𧺹𧻗𧽻𧾣𧿻𨁺𨋧𨍯𨛮𨝮𨧙𨨠𨸰𩉸𩋜𩍔𩍸𩛺𩜃𩧒𩧺𩫴𩬜𩭊𩰤𩳵𩴘𩷐𩿝𪀏𪀣𪁥𪂓𪗖𬶋𮣷𱖑𲌉","juan":"㢧㢾㪻㯞㷷䄅䅌䌸䖭䚈䡓䣺䳪倦劵勌勬卷呟埍奆姢娟巻帣慻捐捲桊涓淃焆狷獧瓹眷睊睠絭絹縳绢罥羂脧臇菤蔨蠲裐鄄錈鎸鐫锩镌隽雋飬餋鵑鹃𠔉𠡶𠢚𡘰𡡀𡫂𡱑𢋄𢍏𢎥𢝓𣙢𣚓𣜨𣬋𣬏𤎱𤲨𤺻𥁠𥅬𥆞𥱽𦊌𦦽𦬾𦮻𦳽𦼱𧎖𧕲𧭦𧯦𨆈𨌫𨤑𨹵𩎳𩏗𩏹𩔱𩜇𩠉","jue":"㔃㔢㟲㤜㩱㭈㭾㰐㲄㵐㷾㸕㹟㻕䀗䁷䇶䏐䏣䐘䖼䘿䙠䝌䞵䞷䠇䡈䣤䦆䦼亅倔傕决刔劂勪匷厥噘噱嚼孒孓屩屫崛嶥弡彏憠憰戄抉挗捔掘撅撧攫斍桷橛橜欔欮殌氒決泬灍焳熦爑爝爴爵獗玃玦玨珏瑴疦瘚矍矡砄絕絶绝臄芵蕝蕨虳蚗蟨蟩覐覚覺觉觖觼訣譎诀谲貜赽趉趹蹶蹷蹻躩逫鈌鐍鐝钁镢駃鴂鴃鶌鷢龣𠀔𠄌𠄑𠊬𠎮𠜾𠢤𠨊𠫃𠶸𡈅𡚠𡲗𡳾𡾜𢁪𢎹𢏷𢔱𢖦𢨏𢩯𢱺𢴭𣅡𣖬𣨢𣬎𤛦𤞴𤟎𤹋𤼗𥆌𥈾𥏘𥕲𥛯𥤘𥾮𦁐𦏅𦓐𦛲𦠒𦪘𧍕𧗫𧝃𧣸𧤼𧥎𧮫𧱝𧺐𧽸𧾵𧿺𨊿𨏹𨬐𨰜𨼎𨼱𩊺𩍷𩏺𩓻𩧏𩧡𩪗𩰨𪁠𪈴𪖜𪚅𪨗𫏋𫔎𫘝𫛞𱉔𱱱","jun":"㑺㒞㕙㖥㚬㝦㴫㻒㽙䇹䐃䕑䜭䝍俊儁军君呁均埈姰寯峻懏捃攈攟晙桾棞汮浚濬焌燇珺畯皲皸皹碅竣箘箟莙菌蚐蜠袀覠軍郡鈞銁銞鍕钧陖餕馂駿骏鮶鲪鵔鵕鵘麇麏麕𠀹𠣕𠨢𢉦𢹲𢻸𤮪𥇘𥚂𥜮𥡣𦇘𦌺𦴌𦵼𧥺𧯖𧽔𨌘𨛐𨲄𨶊𪍁𪕞","ka":"䘔佧卡咔咖喀垰擖胩衉裃鉲","kai":"㚊㪡䁗䒓䡷䤤凯凱剀剴勓嘅垲塏奒嵦开忾恺愒愷愾慨揩暟楷欬炌炏烗蒈輆鍇鎎鎧鐦铠锎锴開闓闿颽𠢲𡙓𡳂𢋝𢔡𢢚𢾆𤉫𤐩𤡲𤻜𥃣𥎆𥏪𥻄𦂄𦈲𨴆𩫀","kan":"㘛㙳䀍䖔䘓䳚侃偘冚刊勘坎埳堪塪墈崁嵁惂戡栞槛檻欿歁看瞰矙砍磡竷莰衎輡轗闞顑龕龛𠝲𡶪𡸞𡺗𢙮𢦟𣊟𣣒𣽌𥍓𥑫𥤱𥦔𦞖𧇦𧡵𧯰𧱄𨍜𨒞𩐬𩑟𩒃𩓟𩜱𪉯𫐘𱂱","kang":"㝩㢜㱂㼹䆲䗧䡉亢伉匟囥嫝嵻康忼慷扛抗摃槺漮炕犺砊穅粇糠躿邟鈧鏮钪閌闶鱇𠻞𠾨𡐓𡵻𡻚𢴦𣔛𤮊𥉽𥒳𥕎𥹺𨀫𨂟𨄗𨎍𨝎𨻷𩾌𪎵𱠏","kao":"㸆䎋䐧䯌䯪丂尻拷攷栲洘烤犒考銬铐靠髛鮳鯌鲓𡭳𣐊𣧏𣨻𣩅𥬯𥹬𧋓𨘴𩝝𩩾","ke":"㕉㕎㝓㞹㤩㪙㪼㵣㸯䆟䈖䌀䐦䙐䶗克刻剋勀勊匼可咳嗑坷堁壳娔客尅岢嵑嵙嶱恪愙揢搕敤柯棵榼樖殼氪渇渴溘炣牁犐珂疴瞌砢碦磕礊礚科稞窠緙缂翗胢艐苛萪薖蝌課课趷軻轲醘鈳钶锞顆颏颗騍骒髁𠏀𠛳𠡜𠡤𠢹𠩧𠪒𠪟𠲙𠳭𠶲𠷄𡞢𡱼𡸡𡻘𢈈𢩏𢩐𢩘𢼐𢾩𣧤𣩄𣲊𣹇𤖇𤛗𤰙𤸎𥃕𥊉𥔽𥝹𥠁𥦨𥧇𥯚𧈗𧎗𧛾𧜡𧠋𧨵𧵛𧿫𨍰𨏿𨢸𩏭𩜭𩭽𩰻𩱘𪃭𪍎𪓮𪽇𮯙𱿣","ken":"㸧啃垦墾恳懇掯肎肯肻裉褃豤錹齦龈𠳁𣍟𣥤𤀊𥖞𨼯𩎤","keng":"㧶㰢䃘䡩䡰劥吭坑妔挳摼牼硁硜硻誙銵鍞鏗铿阬𠠷𡞚𡷨𣢴𣫒𥉸𥑅𥒁𥒱𥥳𧀘𨋔𨌳𨌶𨍑","kong":"㚚㤟㲁㸜䅝倥埪孔崆恐悾控涳硿空箜躻錓鞚鵼𠀝𢃐𢪬𢷙𢽦𣏺𤗇𤤲𥔇𥥅𦁈𦱇𦶐𧌆𧚬𩲧𪔣","kou":"㓂㰯䁱䍍䳹冦剾劶口叩宼寇彄扣抠摳敂滱眍瞉瞘窛筘簆芤蔲蔻釦鷇𠛅𡠆𢂁𢄠𢚫𢟭𢼃𣻎𣿟𤘘𥊧𥲃𦬅𦴎𦶲𧥣𨙫𨥴𩀠𪄺𪇄𫃜𫸩𬆮","ku":"㗄㠸㩿㪂㱠㵠䂗䇢䉐䔯䧊䯇䵈俈刳哭喾嚳圐堀崫库庫廤扝枯桍焅狜瘔矻秙窟絝绔苦袴裤褲趶跍郀酷骷鮬𠠶𠺟𡀙𡑚𡑣𡗵𡞯𡶏𡼿𢏆𢼁𣗺𥈷𥌄𥞴𥟾𥧋𥯶𦛏𦜇𦡆𧊘𧠂𧷎𧿉𧿋𨐡𨡱𩇵𩑔𩑡𩨳𩱙𪌓𪍠𱗞","kua":"㐄㛻㡁䓙䠸䦚䯞侉咵垮夸姱挎胯舿誇跨銙骻𠇗𡇚𡕒𡗢𢄳𢓢𥏤𥑹𨃖𨕺𨵧𩊓𱎶","kuai":"㔞㙕㟴㧟㱮䈛䓒䭝䯤侩儈凷哙噲圦块塊墤巜廥快擓旝狯獪筷糩脍膾蒯郐鄶鱠鲙𠜐𠣲𡚅𡼾𢾒𣫉𥢶𦔦𦳋𨛖𩦱𫐆𲄗","kuan":"㯘䕀䥗䲌宽寛寬欵款歀窽窾臗鑧髋髖𢕫𢴪𣎑𣢻𣽟𥟓𥦀","kuang":"㑌㾠䊯䒰䖱䯑䵃儣况劻匡匩卝哐圹壙夼岲忹恇懬懭抂旷昿曠框況洭爌狂狅眖眶矌矿砿硄礦穬筐筺絋絖纊纩誆誑诓诳貺贶軖軠軦軭邝邼鄺鉱鋛鑛鵟黋𡶢𡾇𢼑𢼳𣍦𣒸𣴥𤝿𥈏𦚞𦥰𧥌𧻔𧿈𨀕𨇁𨏆𨖢𨥑𨨭𨴑𩢼𩬹𩷗𪍿𪏪𫛭𬘢𱖟𲉨","kui":"㒑㕟㙓㙺㚍㨒䕚䕫䖯䙆䙌䙡䟸䠑䤆䧶䫥䯓䯣䰎䳫亏刲匮喟喹嘳夔奎媿嬇尯岿巋巙悝愦愧憒戣揆晆暌楏楑樻櫆欳溃潰煃犪盔睽瞆窥窺篑簣籄聧聩聭聵腃葵蒉蕢藈蘬蘷虁虧蝰謉跬蹞躨逵鄈鍨鍷鐀鑎闚隗頄頍頯顝餽饋馈馗騤骙魁𠊾𠣠𠿥𡌤𡐠𡓰𡤞𢌳𢜽𢼀𣄲𣥮𣧼𤆂𤏜𤵮𥁇𥏙𥜶𥪊𦝢𧂠𧄑𧍜𧑋𧝷𧡦𧢦𧷛𨣈𨾎𨾗𩏣𩓗𩕜𩠮𩲅𩲷𩵉𩹍𪆴𪖢𫠆","kun":"㡓㩲㫻㱎䐊䖵䠅䪲困坤堃堒壸壼婫尡崐崑悃捆昆晜梱涃潉焜熴猑琨瑻睏硱祵稇稛綑菎蜫裈裍裩褌貇醌錕锟閫閸阃騉髠髡髨鯤鲲鵾鶤鹍𠚯𡖉𢈛𣏔𣰘𣱂𥊽𥚛𦄐𦌸𧋕𧥊𨁉𨱙𩓽𩤋𩨫𩻋𩽞𪋆","kuo":"㗥㾧䟯䦢䯺廓懖扩拡括挄擴桰濶筈萿葀蛞闊阔霩鞟鞹韕頢髺鬠𠚳𠠎𡎒𡻙𢠛𤫵𥕏𦧍𦧔𨓈𨨱𨶐𩋻𪗽𱨸","la":"㕇㡴㻋㻝䂰䃳䏀䓥䗶䱨䱫䶛剌啦喇嚹垃拉揦揧搚攋旯柆楋溂爉瓎瘌砬磖翋腊臈臘菈藞蜡蝋蝲蠟辢辣邋鑞镴鞡鬎鯻𠾩𡅘𡉆𢃴𢉨𣤊𤀦𤊶𤛊𤰚𤷟𥀥𥀰𥈙𥖍𥗿𥘁𦅶𦆻𦇛𦎏𦒆𦒦𧗩𧙀𧞪𧩲𨭛𩃜𩋷𩑮𩘊𩤲𩨉𩯽𪇹𬶟𱗌𱴂","lai":"㚓㥎㸊䂾䄤䅘䋱䓶䚅䠭䧒䲚來俫倈唻婡崃崍庲徕徠来梾棶櫴涞淶濑瀨瀬猍琜癞癩睐睞筙箂籁籟莱萊藾襰賚賴赉赖逨郲錸铼頼顂騋鯠鵣鶆麳𠎙𠘝𡂖𡃄𡓒𢅭𢑬𣖤𤢗𤢵𤦃𤲓𦆋𦓹𧯲𧳕𧳟𧵭𨂐𨇆𨦂𩳆𪈈𪑚","lan":"㑣㘓㛦㜮㞩㦨㨫㩜㰖㱫㳕䃹䆾䌫䍀䑌䦨䪍䰐儖兰厱嚂囒囕壈婪嬾孄孏岚嵐幱惏懒懢懶拦揽擥攔攬斓斕栏榄欄欖欗浨滥漤澜濫瀾灆灠灡烂燗燣燷爁爛爤爦璼瓓礷篮籃籣糷繿纜缆罱葻蓝藍蘭褴襕襤襴襽覧覽览譋讕谰躝醂鑭钄镧闌阑韊顲𠓖𠓭𠼖𡒄𡓔𡮻𡽳𢅡𢉧𢊓𢒞𢛓𢹙𣋣𤂺𤃨𤑸𤣟𥌻𥗺𥗽𥜓𥦝𦧼𧮤𧸦𧼖𨅏𨅬𨊔𨎹𨣨𨣸𨬒𨷻𩈵𩉀𩔵𩟺𪇖𬒗𮆏","lang":"㓪㙟㝗㟍㢃㫰㮾㱢㾿䆡䍚䕞䡙䯖䱶勆唥啷埌塱嫏崀廊斏朖朗朤桹榔樃欴浪烺狼琅瑯硠稂筤艆莨蒗蓈蓢蜋螂誏躴郎郒郞鋃鎯锒閬阆駺鿶鿾𠺘𠻴𢳑𢽂𣊧𣻡𥇑𥍫𥧫𦵧𦺫𧚅𧻴𨞿𨱍𨶗𩛡𩲒𩳤𩷕𪁜","lao":"㗦㞠㟉㟹㧯㨓㺐䃕䇭䕩䜎䝁䝤䲏䳓䵏佬僗劳労勞咾哰唠嗠嘮姥嫪崂嶗恅憥憦捞撈朥栳橑橯浶涝潦澇烙牢狫珯痨癆硓磱窂簩粩老耂耢耮荖蛯蟧躼軂轑酪醪銠鐒铑铹顟髝鮱鿲𠈭𡂕𡑍𡬘𢚄𢭂𣘪𣟽𣠼𤉍𤎤𤛮𤩂𤶁𥢒𦒴𦛨𦺜𧢋𧯍𧰎𨡤𨣃𨦭𨲮𪀧𪁔𫭼𱏱𱸇𲄚","le":"㔹㖀㦡乐了仂叻忇扐楽樂氻泐玏砳竻簕肋艻阞韷餎饹鰳鳓𡃖𣂒𤟓𤨙𥖪𩐾𱛔","lei":"㑍㒍㒦㔣㙼㲕㴃㵢㵽㶟㹎㼍㿔䉂䉪䍣䐯䒹䛶䢮䣂䣦䨓䮑䴎傫儡儽勒厽嘞垒塁壘壨嫘擂攂樏檑櫐櫑欙泪洡涙淚灅瓃畾癗矋磊磥礌礧礨禷类累絫縲纇纍纝缧罍羸耒腂蔂蕌蕾藟蘱蘲蘽虆蠝誄讄诔轠酹銇錑鐳鑘鑸镭雷靁頛頪類颣鱩鸓鼺𠱤𡈶𡔇𡚗𡰠𡻭𡻱𡼊𡾋𡾖𡿉𡿛𢴱𢹮𣀀𣀜𣚎𣠠𣡧𣡺𣨅𤃻𤜖𤡂𤢹𤮎𤮚𤮸𤳳𤳴𤼘𥅦𥍔𥑶𥗬𥗶𥣬𥤐𦇄𦓥𦢏𦣄𧒜𧒽𧞭𨀤𨄱𨊚𨞽𨶺𨻌𩔗𩛝𩴻𩵓𪑯𱕰𱰥","leng":"㘄䉄䬋䮚倰冷堎塄崚愣棱楞睖碐稜薐踜輘𥈮𦼊𧼔𨈓𩩡","li":"㑦㒧㒿㓯㔏㕸㗚㘑㛤㟳㠟㠣㡂㤡㤦㦒㧰㬏㮚㯤㰀㰚㱹㴝㸚㹈㺡㻎㻺㼖㽁㽝㾐㾖㿛㿨䃯䄜䅄䅻䇐䉫䊍䊪䋥䍠䍥䍦䍽䓞䔁䔆䔉䔣䔧䕻䖥䖽䖿䗍䘈䙰䚕䟏䟐䡃䣓䣫䤙䤚䥶䧉䬅䬆䮋䮥䰛䰜䱘䲞䴡䴻䵓䵩䶘丽例俐俚俪傈儮儷兣凓刕利剓剺劙力励勵历厉厘厤厯厲吏呖哩唎唳喱嚟嚦囄囇坜塛壢娌娳婯嫠孋孷屴岦峛峢峲巁廲悡悧悷慄戾搮攊攦攭斄暦曆曞朸李杝枥栃栎栗栛梨梩梸棃棙樆檪櫔櫟櫪欐欚歴歷沥沴浬涖溧漓澧濿瀝灕爄爏犁犂犡狸猁珕理琍瑮璃瓅瓈瓑瓥疠疬痢癘癧皪盠盭睝砅砺砾磿礪礫礰礼禮禲离秝穲立竰笠筣篥篱籬粒粝粴糎糲綟縭纚缡罹脷艃苈苙茘荔荲莅莉菞蒚蒞蓠蔾藜藶蘺蚸蛎蛠蜊蜧蝷蟍蟸蠇蠡蠣蠫裏裡褵觻詈謧讈豊貍赲跞躒轢轣轹逦邌邐郦酈醨醴里釐鉝鋫鋰錅鎘鏫鑗锂隶隷隸離雳靂靋驪骊鬁鯉鯏鯬鱧鱱鱳鱺鲡鲤鳢鳨鴗鵹鷅鸝鹂麗麜黎黧礼𠌯𠘞𠘟𠚄𠛘𠛦𠝄𠞉𠞙𠞤𠠏𠠝𠠵𠢠𠩵𠪄𠪺𠫌𠭰𠻗𠼝𠾆𡃷𡆯𡤌𡥽𡫯𡮰𡯄𡳸𡸉𡾒𡿋𡿎𢄡𢌈𢍼𢏃𢛮𢟢𢟤𢡑𢤂𢤆𢤩𢨨𢩑𢮃𢸀𢻠𣀂𣀥𣀷𣁟𣌅𣌜𣐬𣘬𣞴𣟌𣦯𣧿𣫥𣫧𣮉𣯤𣲒𣿞𤁼𤃀𤄽𤇃𤔨𤖢𤗫𤘃𤚓𤜜𤟑𤠫𤡿𤩮𤭜𤳓𤹇𤹈𤻤𤼚𥁟𥉆𥊈𥌛𥌤𥌮𥌿𥎓𥎔𥓃𥝢𥠲𥣥𥨻𥬭𥲧𥲪𥴡𥶗𥷅𥷗𥻿𥼅𥽗𦃇𦃊𦅺𦇔𦎐𦔓𦕸𦘊𦜏𦠓𦢱𦪶𦪾𦫈𦺙𧄚𧄻𧅮𧅯𧉲𧋎𧋠𧑇𧒈𧓽𧔝𧕮𧕯𧘫𧙉𧚩𧢝𧥖𧧋𧫬𧮛𧯏𧰡𧲡𧴠𧽲𨃙𨄛𨇎𨇗𨊛𨍫𨏬𨓦𨘸𨛋𨛫𨜼𨝏𨝖𨝟𨞺𨟑𨢌𨤫𨪹𨬑𨯽𨴻𨷦𨽻𩁟𩄞𩅩𩆝𩆲𩗅𩗭𩘟𩘡𩙖𩞨𩣫𩥬𩥴𩧃𩧋𩪸𩭇𩯺𩰲𩱇𩳓𩴣𩶘𩷋𩻌𩽏𩽵𪁐𪅆𪅼𪌱𪏼𪐅𪒔𪓀𪕴𪖂𪖍𪗁𪙺𪙽𫁡𫄥𫟷𫵷𬍛𭀖","lia":"俩倆","lian":"㜃㜕㜻㝺㟀㡘㢘㥕㦁㪘㪝㯬㰈㰸㱨㶌㶑㺦㼑㼓䁠䃛䆂䌞䏈䙺䥥䨬䭑亷僆劆匲匳嗹噒堜奁奩媡嫾嬚帘廉怜恋慩憐戀摙敛斂梿楝槤櫣殓殮浰涟湅溓漣潋澰濂濓瀲炼煉熑燫琏瑓璉磏簾籢籨練縺纞练羷翴联聨聫聮聯脸臁臉莲萰蓮蔹薕蘝蘞螊蠊裢裣褳襝覝謰蹥连連鄻錬鍊鎌鏈鐮链镰鬑鰊鰱鲢𠋖𠒵𠔨𠗳𡆕𡟤𢅏𢅖𣀃𣝈𣞰𣟺𣿊𤑿𤒦𤗛𤣆𤬓𤹨𤼏𤾲𥖝𥲥𥽸𦆆𦈐𦔖𦖾𦣸𧍴𧐖𧡙𧡴𧸘𧽫𨎷𨏩𨏶𨬁𨽷𩄡𩞙𩟅𪍦𪍴𪐋𪐍𪖳𪚁𪛒𬶠𱘉𱧚𱬏","liang":"㒳㔝㹁㾗䀶䁁䓣䝶䠃䣼䩫䭪両两亮俍兩凉哴唡啢喨墚悢掚晾梁椋樑涼湸煷粮粱糧綡緉脼良蜽裲諒谅踉輌輛輬辆辌量鍄魉魎鿄鿌𠓜𠯱𡑆𡮎𣄴𣓈𤙝𥈘𥛫𨄈𨎛𨱉𨵶𩗾𩘁𩞯𫟅𬜯𮉧𮔊𮖁𱜬","liao":"㙩㝋㡻㵳㶫䄦䉼䎆䑠䒿䜍䜮䢧䨅䩍僚叾嘹嫽寥寮尞尥尦屪嵺嶚嶛廖廫憀憭撂撩敹料暸曢漻炓燎爎爒獠璙疗療瞭窷竂簝繚缭聊膋膫蓼藔蟟豂賿蹘蹽辽遼鄝釕鐐钌镣镽飉髎鷯鹩𠐋𠖂𠨥𡻪𢄷𢊻𢨺𢸘𢻢𢼙𢿞𣁰𣎸𣟆𣩢𤊽𤑗𤵠𥗀𥛰𥲊𦌒𦕵𦗖𦪕𦺹𧂏𧘈𧝜𧡜𧽽𨖚𨣀𩕐𩖝𩯊𩴤𪌀𪌵𪖷𪤗","lie":"㤠㧜㬯㭞㭩㯿㲱㸹㼲㽟䁽䅀䉭䋑䜲䝓䟩䟹䪉䴕儠冽列劣劽咧哷埒埓姴巤挒挘捩擸栵毟洌浖烈烮煭犣猎猟獵睙聗脟茢蛚裂趔躐迾颲鬛鬣鮤鱲鴷𠛱𠠗𡁓𡂏𡂩𡊻𡏵𡒏𡓍𡭣𡿩𢣓𣁷𣁻𣋲𣖊𣝚𣰌𤁯𤐱𤓿𤖺𤜓𤞊𤡕𤢪𤱃𤱛𥪂𥲁𥶢𥷨𥸸𦓤𦖩𦾳𧀨𧓐𧞕𧭌𧭞𧰠𨆍𨕜𨤤𩆣𩙑𩢾𩧆𩧮𩨐𩭌𩼭𫚭","lin":"㐭㔂㖁㝝㨆㷠䉮䕲䗲䚏䚬䢯䫐䫰䮼临亃僯冧凛凜厸吝啉壣崊嶙廩廪恡悋懍懔拎撛斴晽暽林橉檁檩淋潾澟瀶焛燐獜琳璘甐疄痳癛癝瞵碄磷箖粦粼繗翷膦臨菻蔺藺賃赁蹸躏躙躪轔轥辚遴邻鄰鏻閵隣霖驎鱗鳞麐麟𠐼𠓮𡃦𡬜𡰚𡳞𡶱𡹇𡻫𡿠𣇰𤂶𤌎𤎭𤗷𥓆𥳞𥶒𥷖𥻋𥼭𦺸𧃮𧖔𧲂𧵧𧶆𧹩𨏨𨸻𩞻𩣖𩱬𩴠𩻜𬘭𬭸𬴊","ling":"〇㖫㡵㥄㦭㪮㬡㯪㱥㲆㸳㻏㾉䄥䈊䉁䉖䉹䌢䍅䔖䕘䖅䙥䚖䠲䡼䡿䧙䨩䯍䰱䴇䴒䴫令伶凌刢另呤囹坽夌姈婈孁岭岺嶺彾掕昤朎柃棂櫺欞泠淩澪瀮灵炩燯爧狑玲琌瓴皊砱祾秢竛笭紷綾绫羚翎聆舲苓菱蓤蔆蕶蘦蛉衑袊裬詅跉軨酃醽鈴錂铃閝阾陵零霊霗霛霝靈領领駖魿鯪鲮鴒鸰鹷麢齡齢龄龗𠄖𠟨𠠢𠡭𠱠𠻠𠻱𠾥𡈍𡕮𡿡𢌔𢔁𢩗𢹝𢺰𣌟𣣋𣬹𤃩𤖦𤜙𤣘𤧘𤨻𤫩𤫲𤷖𤿅𥌼𥤜𥤞𥥋𥩔𥵝𥺙𥾂𦉢𦊓𦫃𦫊𧆺𧕅𧖜𧟙𧨈𧰻𧱢𧲙𧾇𧾮𨞎𨠎𨱋𨽲𩂙𩃞𩄊𩆒𩆚𩆮𩆻𩆼𩇄𩇎𩊂𩑊𩖊𩖵𩚹𩜁𩟃𩪥𩬔𩲩𩵀𪅋𪋳𪋾𪌏𪕌𪛈𫐉𱗟𱰮","liu":"㐬㙀㧕㶯㽌㽞䄂䉧䗜䚧䝀䬟䰘䱖䱞䶉六刘劉嚠塯媹嬼嵧廇懰旈旒柳栁桞桺榴橊橮沠流浏溜澑瀏熘熮珋琉瑠瑬璢畂畄留畱疁瘤癅硫磂磟綹绺罶羀翏蒥蓅藰蟉裗蹓遛鋶鎏鎦鏐鐂锍镏镠雡霤飀飂飅飗餾馏駠駵騮驑骝鬸鰡鶹鷚鹠鹨麍𠗽𠛓𠪐𠺕𢏭𢔲𢞭𢣠𢤐𢷶𣞗𣟑𣠚𣱳𤥗𤮷𥀓𥆦𥌐𥛅𥠷𥥹𥧕𥨌𥰣𥶅𥹷𦀠𦃓𦉉𦊑𦊗𦊿𦌁𦑾𧏓𧮗𨋖𨍸𨢇𨦰𨪕𨪿𨻧𩆎𩖴𩗩𩙄𩢞𪃂𪆱𪇯𪎣","lo":"囖","long":"㑝㙙㚅㛞㝫㟖㡣㢅㦕㰍㳥㴳䃧䆍䏊䙪䡁䥢䪊䮾儱咙哢嚨垄垅壟壠屸嶐巃巄徿拢攏昽曨朧栊梇槞櫳泷湰滝漋瀧爖珑瓏癃眬矓砻礱礲窿竉竜笼篢篭籠聋聾胧茏蕯蘢蠪蠬襱豅贚躘鏧鑨陇隆隴霳靇驡鸗龍龒龓龙𠮽𠱚𠾐𡃡𡬕𡱯𢙱𢤱𢤲𢸭𣫣𤵸𤼃𤾭𥦌𥪢𥪻𥬆𥳌𥸉𦨩𦪽𧍰𧙥𧚂𨇘𨏠𨐇𨛓𨺚𩂽𩄺𩙘𩙠𩟭𩧪𪐖𪔳𪔷𪚑𪚓𪚘𪚝𪚠𫢒𱛓","lou":"㔷㟺㡞㥪㪹㲎㺏䁖䄛䅹䝏䣚䫫䮫䱾偻僂剅喽嘍塿娄婁屚嵝嶁廔慺搂摟楼樓溇漊漏熡甊瘘瘺瘻瞜篓簍耧耬艛蒌蔞蝼螻謱軁遱鏤镂陋鞻髅髏𠖛𠗩𠞭𠳴𡇭𡗆𡪅𡰌𢈢𣤋𣫻𤋏𤠋𤬏𥕍𦎹𦸢𧁾𧢃𧫞𧯨𧰃𧷡𨄋𨝢𨦖𨫒𨱐𨻻𩏝𩨇𪍣𪣻𫠥","lu":"㓐㔪㖨㛬㜙㟤㠠㢚㢳㦇㪐㪖㪭㫽㭔㯝㯟㯭㱺㼾㿖䃙䌒䍡䎑䎼䐂䘵䚄䟿䡎䡜䩮䮉䰕䱚䲐䴪侓僇剹勎勠卢卤噜嚕嚧圥坴垆塶塷壚娽峍庐廘廬彔录戮掳摝撸擄擼攎曥枦栌椂樐樚橹櫓櫨氇氌泸淕淥渌滷漉潞澛瀂瀘炉熝爐獹玈琭璐璷瓐甪盝盧睩矑硉硵碌磠祿禄稑穋箓簏簬簵簶籙籚粶纑罏胪膔臚舮舻艣艪艫芦菉蓾蔍蕗蘆虂虏虜螰蠦觮謢賂赂趢路踛蹗輅轆轤轳辂辘逯醁鈩錄録錴鏀鏕鏴鐪鑥鑪镥陆陸露顱颅騄騼髗魯魲鯥鱸鲁鲈鵦鵱鷺鸕鸬鹭鹵鹿麓黸𠀽𠿛𡀔𡉴𡳴𡴆𡷏𢊩𢋡𢟧𢫫𢯅𢲸𢵮𢾬𣆐𣞓𣥐𣩏𣱀𣼟𤝮𤟘𤢊𤣃𤨍𤬛𤮧𤺼𤻱𤽺𤿴𥀔𥀵𥈛𥉶𥒨𥚊𥛞𥛪𥣤𥫰𥲎𥶇𦋔𦌕𦌟𦗓𦪇𦸐𦼋𦽂𦽎𦾞𦾷𦿊𦿖𧀦𧆣𧇄𧌉𧌍𧐳𧨹𧫓𧽥𨁸𨇖𨌠𨏔𨽐𩄅𩅄𩌫𩍼𩓪𩛼𩣱𩯜𪉖𪉣𪍄𪑄𪒏𪖌𪾦𫘧𬬻𮉡𮬠𱏤𱸧","luan":"㝈㡩㱍䖂䜌乱亂卵圝圞奱娈孌孪孿峦巒挛攣曫栾欒滦灓灤癴癵羉脔臠虊釠銮鑾鵉鸞鸾龻𠦨𡄹𡡗𡭸𢌕𢺈𢿢𣨀𤔔𤲶𤼙𦣋𦣏𧖘𨄄𨇼𨈌𨈎𨊟𩪾𪢮𰁜𱤦","lun":"㖮㷍䈁䑳仑伦侖倫囵圇埨婨崘崙惀抡掄棆沦淪溣碖磮稐綸纶耣腀菕蜦論论踚輪轮錀陯鯩𠔕𠼩𡃝𤲕𤷔𦓾𧣵𧱜𪨧𫭢𬬭𱛍𲉉","luo":"㑩㒩㓢㞅㦬㩡㪾㰁㱻㴖㼈㽋㿚䀩䇔䈷䉓䊨䌱䌴䎊䯁倮儸剆啰囉峈摞攞曪椤欏泺洛洜漯濼犖猡玀珞瘰癳硦笿箩籮絡纙络罖罗羅脶腡臝荦萝落蓏蘿螺蠃裸覙覶覼躶逻邏鏍鑼锣镙雒頱饠駱騾驘骆骡鮥鴼鵅鸁𠉗𠏢𠜖𠶱𠻐𠻡𡁆𡆆𡤢𡿏𢅾𢺆𢺑𣂞𣎆𣛗𣜄𣜢𣧳𣨪𣵟𤄷𤔖𤔝𤗀𤨗𤽥𤽼𥡜𥯛𦆁𦣇𦣖𦣛𦿌𧄿𧈦𧟌𧭥𧷳𧹐𨇽𨏒𨟥𨬅𨰠𩂣𩉙𩊚𩌭𩍪𩎊𩮹𩵇𩼊𩽰𪇱𪈰𪌳𪎆𪑋𫌨𫗩𭹜","lv":"㔧㛎㠥㭚㲶㻲㾔䔞䕡䥨侣侶儢勴吕呂垏寽屡屢履嵂律慮挔捋捛旅梠榈櫖櫚氀氯滤濾焒爈率祣稆穞穭箻絽綠緑縷繂绿缕膂膐膟膢葎藘虑褛褸郘鋁鑢铝閭闾馿驢驴鷜𠜈𠣊𠷈𡀿𡡎𡾅𢅞𢈚𢙲𢟳𢣻𢯰𣀞𣭇𤁵𤗬𤝽𤾺𥖼𥡢𥭐𥰠𥶆𥶌𦆾𦊼𦛗𦝼𦭯𦳭𧃒𧈔𧍶𧓻𧜊𧭜𩄽𩥆𩲦𩳡𩴐𪈜𮣶","lve":"㑼㔀㗉㨼䂮䌎䛚䤣圙掠擽略畧稤鋝鋢锊𠢌𠼟𦊹𧎾𧐋𧐯𧑀𧕌𪅅","m":"呣","ma":"㐷㑻㜫㦄㨸㾺䗫䣕䣖䧞䯦䳸亇傌吗唛嗎嘛嘜妈媽嫲嬤嬷孖杩榪溤犘犸獁玛瑪痲睰码碼礣祃禡罵蔴蚂螞蟆蟇遤鎷閁馬駡马骂鬕鰢鷌麻𡻤𢉿𢋚𢳀𣨜𤳂𥀏𥉊𥉵𥧓𧪨𨰾𩀪𩊃𩔶𩔷𩨲𩶞𪐎𪒜𪓹","mai":"㜥㦟䁲䘑䚑䜕䥑䨪䨫䮮买佅劢勱卖嘪埋売脈脉荬蕒薶衇買賣迈邁霡霢霾鷶麥麦鿏鿺𠿆𢠼𥇯𥌚𦏢𦙻𧱘𨤢𩈗𩊍𩍃𪄳𪒪𱧴𲋶","man":"㒼㗈㙢㛧㡢㬅㵘䅼䊡䐽䒥䕕䛲䜱䝡䝢䟂䡬䯶䰋僈墁姏嫚屘幔悗慢慲摱曼槾樠満满滿漫澷熳獌睌瞒瞞矕縵缦蔄蔓蘰蛮螨蟎蠻襔謾谩鄤鏋鏝镘鞔顢颟饅馒鬗鬘鰻鳗𡢚𡻩𢦈𢿜𣁜𤅎𤜘𥊑𥧭𥬈𥲈𥲑𦎌𦔔𧆏𧖵𧜞𧱼𨲛𨲾𩅍𩆓𩈦𩛎𩮉𪈿𪍩𪑪𬜬","mang":"㝑㟌㟐㟿㡛㤶㬒㻊䁳䅒䈍䒎䓼䖟䵨吂哤壾娏尨庬忙恾杗杧氓汒浝漭牤牻狵痝盲硥硭笀芒茫茻莽莾蛖蟒蠎邙釯鋩铓駹𠈵𠮵𡅖𡘪𡩩𡩽𡵀𣙷𣯬𤛘𤰡𥁃𥆙𥐞𥝕𥤩𥭚𥮎𦎨𦜭𨛌𩅁𩒿𩙸𩛲𩪎𩭒𩷶𪁪𪚢","mao":"㒵㒻㚹㝟㡌㧇㧌㪞㫯㮘㲠㴘㺺㿞䀤䅦䋃䓮䡚䫉䭷乮兞冃冇冐冒卯堥夘媢峁帽愗懋戼旄昴暓枆柕楙毛毷氂泖渵牦犛猫瑁皃眊瞀矛笷罞耄芼茂茅茆萺蓩蝐蝥蟊袤覒貌貓貿贸軞鄚鄮酕鉚錨铆锚髦髳鶜𠔼𠤝𡜢𡹰𢂹𢅉𢘅𢝌𢨯𢯾𢽢𣊃𣔺𣨇𣬵𣭮𣯀𣴟𣴼𣹪𤚜𤛖𤝄𤥰𤲰𥄸𥈆𥎟𥟪𦀸𦼪𧍟𧐟𧒚𧓿𧔨𧠊𨈥𨥨𨦜𨩩𨺸𩛨𩫁𩬞𩭾𩿂𪃑𱚦𲌰","me":"么嚒嚜濹癦麼","mei":"㙁㭑㺳䀛䆀䉋䊈䍙䓺䜸䤂䰨䰪䵢凂呅坆堳塺妹娒媄媒媚媺嬍寐嵄嵋徾抺挴攗旀昧枚栂梅楣楳槑毎每沒没沬浼渼湄湈煝煤燘猸玫珻瑂痗眉眛睂睸矀祙禖穈篃美脄脢腜苺莓葿蘪蝞袂跊躾郿酶鋂鎂鎇镁镅霉韎鬽魅鶥鹛黣黴𠊉𠍨𠪃𡲭𢮇𣟸𤚤𤽃𥞊𥧴𦼻𧭵𧳬𨉭𨜘𩈐𩋿𩎟𩫍𩲈𩴈𪂜𪃏𪉏𪎦𪎭","men":"㥃㦖㱪㵍䊟䫒们們悶懑懣扪捫暪椚焖燜玧璊菛虋鍆钔門閅门闷𣯩𤅣𧄸𧴺𨳔𨴺𩑥𩔉𫞩","meng":"㙹㜴㝱㠓㩚䀄䁅䇇䉚䏵䑃䑅䒐䓝䗈䙦䙩䟥䠢䤓䥂䥰䰒䲛䴌䴿䵆儚冡勐夢夣孟幪懜懞懵掹擝曚朦梦橗檬氋溕濛猛獴瓾甍甿盟瞢矇矒礞艋艨莔萌蒙蕄蘉虻蜢蝱蠓鄳鄸錳锰霥霿靀顭饛鯍鯭鸏鹲鼆𠐁𠐧𠖆𠵼𡒯𡚔𡬆𡬌𢄐𢕙𢤘𢿂𣊔𣓝𣞑𣰥𣽭𤯻𤱴𤼁𤾬𥂂𥄁𥉕𥋝𥌯𥌱𥣛𥭮𦆟𦊽𦢧𦫰𦱋𦳶𦴔𦷹𦿏𧀆𧀧𧁊𧂛𧂡𧓨𧞑𧭊𧲍𨞫𨢊𨢠𨣘𨨸𨮒𨼿𩄖𩆽𩕱𩟞𩦺𩴲𩶡𪅇𪇓𪈆𫑡𲎈","mi":"㜆㜷㝥㟜㠧㣆㥝㨠㫘㳴㳽㴵㵋㵥㸏㸓䁇䈿䉲䊳䋛䌏䌐䌕䍘䕳䕷䖑䛑䛧䣾䤉䤍䥸䭧䮭䱊䴢侎冖冞冪咪嘧塓孊宓宻密峚幂幎幦弥弭彌戂擟攠敉榓樒櫁汨沕沵泌洣淧渳滵漞濔濗瀰灖熐爢猕獼瓕眫眯瞇祕祢禰秘簚米粎糜糸縻羃羋脒芈葞蒾蔝蔤藌蘼蜜袮覓覔覛觅詸謎謐谜谧迷醚醾醿釄銤镾靡鸍麊麋麛麿鼏鿹𠞧𡄣𡇒𡊭𡓭𡝠𡬍𡲼𡾱𢆯𢇲𢘺𢞞𢱮𣓔𣧲𤛬𤦀𥁑𥇆𥇎𥈕𥉴𥉿𥎖𥧧𥭫𥮜𥵨𥹄𥹫𥽰𥿫𦖬𦗕𦞟𦟂𦣥𦰴𦸡𧐎𧕵𧠟𧱻𧵬𧶡𧷦𧼊𧽨𨇻𨒲𨢎𨢥𨣯𨣾𨷬𩔢𩞇𩸹𪀿𪅮𪋗𪋢𪎔𪎗𪑸𪒄𪓬𪕈𬵨𲄀𲍐𲍰","mian":"㒙㝃㝰㤁㨺㮌㰃㴐㻰䀎䃇䏃䛉䤄䩄䫵䰓丏偭免冕勉勔喕娩婂媔嬵宀愐杣棉檰櫋汅沔渑湎澠眄眠矈矊矏糆絻綿緜緬绵缅腼臱芇葂蝒面靣鮸麪麫麵麺黽𡒳𡕢𡧍𡧒𡯫𢃮𢣔𣅍𣡠𣧾𥄝𥊿𥌂𥤵𥻩𦬛𦽃𧭇𧸨𨉥𨟺𨡞𩈹𩋠𩾃𪁼𱺨","miao":"㑤㦝䁧䖢喵妙媌嫹庙庿廟描杪淼渺玅眇瞄秒竗篎緢緲缈苗藐邈鱙鶓鹋𠋝𡡺𢚋𢤧𢷕𤾛𥭝𦳥𩳸𪃐𪃦","mie":"㒝㩢䁾䈼䌩䘊䩏乜吀咩哶孭幭懱搣櫗滅灭烕篾蔑薎蠛衊覕鑖鱴鴓𠺗𡖺𡞙𡟬𢦼𢧞𢨖𤊾𤏿𥄲𥉓𥋚𥣫𥵒𥸴𥾝𦇪𧀅𧂝𨣱𩔠𩱷𪇴𪌺𪒍","min":"㞶㟩㟭㥸㨉㬆䁕䂥䃉䋋䝧䟨䡑䡻䪸䲄僶冺刡勄垊姄岷崏忞怋悯惽愍慜憫抿捪敃敏敯旻旼暋民泯湣潣珉琘琝瑉痻皿盿砇碈笢笽簢緍緡缗罠苠蠠鈱錉鍲閔閩闵闽鰵鳘鴖黾𠊟𢼖𢽹𣱈𣱉𣷠𣹒𤇜𤛎𤸅𤺖𤿕𥜐𦈏𦌡𦫮𦳜𧁋𧌙𧲃𨏵𩭷𪂆𪄴𪉎","ming":"㝠㟰㫥䄙䆩䊅䒌䫤䳟佲冥凕名命姳嫇慏掵明暝朙椧榠洺溟猽眀眳瞑茗蓂螟覭詺鄍酩銘铭鳴鸣𠋶𡥸𥌏𥥊𥹆𥿨𦡉𦫭𧟠𧱴𩣶𪂤𪗸","miu":"謬谬𨱯","mo":"㱳㶬㷬㷵㹮䁼䁿䃺䏞䒬䘃䩋䬴䭩䮬䯢䱅䳮䴲劘劰唜嗼嚤嚩嚰圽塻墨妺嫫嫼寞尛帓帞庅怽懡抹摩摸摹擵昩暯末枺模橅歾歿殁沫湐漠瀎爅獏瘼皌眜眽眿瞐瞙砞磨礳秣粖糢絈纆耱膜茉莈莫蓦藦蘑蛨蟔謨謩谟貃貊貘銆鏌镆陌靺饃饝馍驀髍魔魩魹麽默黙𠆮𠇱𠡞𠢓𠬛𠻚𡈗𡊉𡠜𡡉𡢜𡻟𡾉𢄏𢊗𢐖𢗿𢣗𣋟𣧣𣶊𣻕𤋂𤣻𤹴𤿖𥂓𥄕𥕓𥙎𥞪𥬎𥱹𥽘𦅔𦔭𦟟𦥦𦫕𦮅𧕤𧕥𧠓𧥟𧰱𧻙𧼟𧿴𨆽𨟖𨰞𨱱𩃁𩄻𩌧𩐻𩑦𩑷𩞁𩟠𩢖𩢷𩥔𩪮𩿣𪍇𪍤𪎠𪏟𪒂𪒇𬙊𱚯𲎉","mou":"㭌䋷䍒䏬䗋䥐䱕侔劺哞恈某洠牟眸瞴繆缪蛑謀谋踎鉾鍪鴾麰𠀱𠥨𢃱𣫬𥆆𥿵𦊋𦊎𦋡𦭷𦳑𦺒𧎄𨴍𩢫𩶢","mu":"㜈㟂㣎㧅㾇䀲䊾䑵䥈䱯亩仫凩募坶墓墲姆峔幕幙慔慕拇暮木朰楘母毣毪氁沐炑牡牧牳狇畆畒畝畞畮目睦砪穆縸胟艒苜莯蚞踇鉧鉬钼雮霂鞪𠺖𡵬𢘃𢜯𢟨𣈊𤚅𤝂𤝕𤵝𥄈𥣸𥰻𦃤𦱒𧚀𧩒𧬏𧰷𧿹𨈶𨍎𨎸𨡭𨢢𩡨𩬍𩵦𩶖𩶩𪎫𬭁𬰃","n":"㕶嗯𠮾","na":"㨥㵊䇱䈫䎎䏧䖓䖧䛔䟜䪏䫱乸吶呐哪嗱妠娜拏拿挐捺笝納纳肭蒳衲袦豽貀軜那鈉鎿钠镎雫靹魶𠕄𠱲𠴾𡤙𡰀𡷝𢇵𢜲𢡏𣅚𣡰𣸏𣹵𤓷𤔀𤝒𤬷𤭠𤱅𤱆𤷈𤸏𤸻𥍲𥑒𥹉𥿃𦙜𦛐𦣀𦬻𦰡𧋡𧘽𧤣𧦮𧰹𨙻𨚗𩏼𩚛𩟿𩮅𩹾𪌅𪐀𪗝𱥷","nai":"㜨㮈㮏㲡㴎㾍䍲䘅䯮乃倷奈奶嬭孻廼摨柰氖渿熋疓耏耐腉艿萘螚褦迺釢錼鼐𠧤𡞫𡨵𡮙𢉓𣉘𣮦𥉃𦓎𦔹𦠸𦳐𦶅𨎡𩹟𪌞","nan":"㓓㫱㬮㽖䈒䊖䔜䛁䶲侽南喃囡娚婻戁抩揇暔枏柟楠湳煵男畘腩莮萳蝻諵赧遖难難𡆤𡆱𡆲𢪈𢬷𤌔𤱣𤽲𤿏𦍀𦛚𦝧𦶈𧇙𧕴𧹞𨠹𨦳𨴌𨴘𨵴𩅠𩈑𩈶𩹞","nang":"㚂㶞䁸乪儾嚢囊囔擃攮曩欜灢蠰譨饢馕鬞齉𠶬𡿝𢖧𦈃𦗳𦣘𧅺𧖒𧟘𨳆𩜒𱘭𱜀","nao":"㑎㛴㞪㺁䃩䛝䜀䜧䴃匘呶垴堖夒嫐孬峱嶩巎怓恼悩惱憹挠撓淖猱獶獿瑙硇碙碯脑脳腦臑蛲蟯詉譊鐃铙閙闹鬧𠊦𠡷𡍍𡽧𡾂𡿺𢅈𢉵𢙐𢜸𢪼𣧽𣭺𤊲𤋫𤞍𤡤𤫕𤷻𥀮𥆲𥐻𥑪𥒢𦗮𧩣𧳦𧴓𧴙𨥸𨱵𩋈𩖯𩛋𩤘𩩀𩫔𩫺𩬷𩯆𫍢𬆛","ne":"㕯䅞䎪䭆呢抐疒眲訥讷𢗉𣧍𧤜","nei":"㐻㨅㼏䲎內内娞氝脮腇錗餒馁鮾鯘𠑚𠑛𡣢𢁩𢅼𢛉𣓃𥡭𨡌𩗔𩬀","nen":"㜛㯎㶧嫩嫰恁𡞾𧮠𨈗","neng":"㲌㴰䏻能𠹌𢆂𨃳𨶙","ni":"㞾㠜㥾㦐㩘㪒㲻㵫㹸䁥䕥䘌䘦䘽䛏䝚䦵䵑䵒伱伲你倪儗儞匿坭埿堄妮妳婗嫟嬺孴尼屔屰怩惄愵抳拟擬旎昵晲暱柅棿檷氼泥淣溺狔猊眤睨秜籾縌聣聻胒腝腻膩臡苨薿蚭蜺觬誽貎跜輗迡逆郳鈮铌隬霓馜鯢鲵麑齯鿭𠆵𠱘𠸺𠽬𡎳𡎿𡞭𡣁𡥦𡥨𡫸𡬗𢅟𢘝𢚮𢛜𢣚𢦱𣘗𣡋𣢞𣭙𣲷𤙌𤦤𥄽𥇄𥜦𥜬𥷄𥺜𦆦𦤽𦦃𦮾𦰫𧃩𧈞𧏾𧖷𧵼𧺰𨀀𨋗𨺙𨽦𩈢𩉹𩋪𩍦𩚯𩩢𩯨𩰞𩱄𩸦𩸧𩺝𩺱𩾆𪏵𪏸𪐌𪙛𫐐𫠜","nian":"㜤㞋㮟㲽䄭䄹䚓䧔䬯卄哖唸埝姩年廿念拈捻撚撵攆涊淰焾碾秊秥簐艌蔫跈蹍蹨躎輦辇辗鮎鯰鲇鲶鵇黏𠕟𠗋𠣇𠫺𡝟𡰫𣎔𣐏𤁥𤽿𥮘𥺴𦁇𦭁𦷙𨇍𨋚𨚶𨢯𨴞𩉄𩊫𩽴𪐇𪑮","niang":"䖆娘嬢孃酿醸釀𥽬𪓃","niao":"㒟㜵㞙㠡㭤㳮䃵䙚䦊䮍嫋嬝嬲尿樢脲茑蔦袅裊褭鳥鸟𠒰𡘏𡝋𡝒𡠿𢶑𢸣𣟊𥤂𥾇𨳀𨽖𩖔𩭑𪅝𪈼𱗅𱛆","nie":"㖏㖕㖖㘝㘨㘿㙞㚔㜸㡪㩶㮆㴪㸎䂼䄒䇣䌜䌰䡾䯀䯅䯵䳖啮喦嗫噛嚙囁囓圼孼孽嵲嶭巕帇惗捏揑摰敜枿槷櫱涅湼痆篞籋糱糵聂聶臬臲苶菍蘖蠥讘踂踗踙蹑躡錜鎳鑈鑷钀镊镍闑陧隉顳颞齧𠈊𠶿𡆣𡍤𡰆𡴎𡶫𡸣𡾦𡾲𡿖𡿗𢈸𢫻𣀳𣌍𣙗𣯭𣰼𤭂𤴘𤶚𤺐𥔄𥬞𥬬𥮤𦄌𦈙𦘒𦛠𦞆𦯖𦵐𧁈𧋖𧞍𧻼𨊞𨙓𨱺𨲀𨶠𨻄𩋏𩐭𩒕𩖁𩣘𪌊𪌿𪎃𪎅𫔶𬛸","nin":"㤛䋻囜您拰脌𠽝","ning":"㝕㣷㲰㿦䆨䔭䗿䭢佞侫倿儜凝咛嚀嬣宁寍寕寗寜寧拧擰柠橣檸泞澝濘狞獰甯矃聍聹苧薴鑏鬡鸋𡫃𣍆𤕦𤹧𤻝𥣗𥧤𥳥𦡲𦡼𧃱𧑗𧕝𧭈𩕳𫛢𮫂","niu":"㖻㺲䂇䋴䏔䒜妞忸扭汼炄牛牜狃紐纽莥鈕钮靵𣧊𣲶𥀝𥍳𥝦𧘥𨋀𨙺𨳞𨷁𩈇𩙷𩚖𩲍𩵠𪏲","nong":"㶶㺜䢉䵜侬儂农哝噥弄挊挵檂欁浓濃燶癑禯秾穠繷脓膿蕽襛農辳醲齈𠌚𠘊𥂒𨑊𨲳𩅽𩇔𩟊𪆯𪒬𬪩","nou":"㜌㝹㳶䅶䘫䨲䰭啂槈檽獳羺耨譳鎒鐞𠲴𡝦𡨻𡭾𢉕𢉚𣻖𤟦𥀫𧂦𧃨𧅘𩆟𩒔𪋺","nu":"㚢伮傉努奴孥弩怒搙砮笯胬駑驽𠴂𢪦𢫓𢫭𥅄𥛑𥤨𥱂𧉭𧗈𧪅𧿔𪺹","nuan":"㬉奻暖渜煖煗餪𪋐","nun":"黁","nuo":"㐡㑚㔮㖠㛂㡅㰙䚥傩儺喏愞懦懧挪掿搦搻梛榒橠稬穤糑糥糯諾诺蹃逽郍锘𠸱𠹈𡖔𡖫𡬥𡿊𢜪𢰜𢾲𣃽𣆚𤘟𥑽𥻾𦀨𦂍𦓢𦡃𦩜𧣚𧣺𨁌𨎭𩈺𩴓𩷁","nv":"㵖䖡䘐䚼䶊女恧朒沑籹衂衄釹钕𥄋𥍞𦓕𦓖","nve":"䖈䖋䨋疟瘧硸虐𨵫","o":"哦喔噢","ou":"㒖㼴䉱䌂䌔䙔䥲偶吘呕嘔塸怄慪櫙欧歐殴毆沤漚熰瓯甌筽耦腢膒蕅藕藲謳讴鏂鴎鷗鸥齵𠙶𠢔𠥝𠴰𡂿𡈆𡩾𣂻𣉾𣓕𣢨𣽕𤁮𤛐𤵎𥈬𥐂𥧆𥱸𥻑𦂕𧖼𧪓𩀫𩔸𩥋𪊪𪙃𫭟𬉼𱸂","pa":"䔤䯲啪妑帊帕怕掱杷潖爬琶皅筢舥葩袙趴𣚒𣧜𣱺𤆵𤽉𥐙𥩙𦐆𧑡𧣃𧣣𨋐𩈆𪗔𱍕","pai":"㭛㵺䖰䱝俳哌廹徘拍排棑派渒湃牌犤猅簰簲蒎輫鎃𠂢𠸁𣏟𣖐𣝁𣲖𣴪𥯟𥱼𥴖𥿯𦔠𦩯𦫖𧵠𩛇𱖼","pan":"㐴㢖㽃䃲䆺䰉䰔冸判叛媻幋拚搫攀槃沜泮洀溿潘瀊炍爿牉畔畨盘盤盼眅磐磻縏聁萠蒰蟠袢襻詊跘蹒蹣鋬鎜鑻鞶頖鵥𠽲𡞟𢰿𣁦𣔚𤄜𤄧𤖭𤠍𤺏𤻷𥈼𥉟𥌊𥕿𦙀𦪹𧺾𨂝𨃞𨃟𨒃𪄀𪒀","pang":"㕩㥬㫄䅭䏺䒍䠙䨦乓厐厖嗙嫎庞彷徬旁沗滂炐耪肨胖胮膖舽螃覫逄雱霶鳑龎龐𠗵𠦲𡅃𢐊𣂆𤧭𥪴𦜍𦣂𧔧𧿆𨜷𩃎𩅅𩈈𩐨𪐿𪔔","pao":"㘐㚿㯡㯱㲏䩝䫽䶌刨匏咆垉奅庖抛拋泡炮炰爮狍疱皰砲礟礮脬萢袍褜跑軳鞄麃麅麭𠣳𡂘𡧙𡯈𡾌𢾳𣕅𣚇𣟏𣮃𣶐𤔉𥶔𦐸𦠖𧙌𨋛𨣙𩂞𩆘𩎘𩎾𩐜𩗥𪊳𲋏","pei":"㚰㟝㤄㧩㯁㳈㾦䊃䣙䫊伂佩俖呸培姵嶏帔怌斾旆柸毰沛浿珮肧胚蓜衃裴裵賠赔轡辔配醅锫阫陪霈馷駍𢁖𢘀𢥐𣍺𣬆𣯱𤗏𤬃𥄔𥹂𦙂𦸪𧳏𧴥𨓿𨙶𨛬𩎜𩑢𩖭𩵣𬇙","pen":"㖹呠喯喷噴歕湓瓫盆翸葐𠺔𠽾𡺜𪂽","peng":"㛁㠮㥊㧸㱶㼞䄘䍬䡫䥋䦕䰃䴶倗剻匉嘭堋塳弸彭怦恲憉抨挷捧掽朋梈棚椖椪槰樥淎漰澎烹熢皏砰硑硼碰磞稝竼篣篷纄膨芃莑蓬蘕蟚蟛踫軯輣錋鑝閛韸韼騯髼鬅鬔鵬鹏𡂫𡗗𡼜𢏳𢪋𢼩𢽩𣟀𣨞𤖳𤘾𥕱𥕽𦚝𦪪𦯰𧌇𧚋𧴂𨂃𨅘𨍩𨎧𨎳𨑎𨠟𨲰𨺀𩄦𩐛𩖛𩡕𩱀𩸀𪔍𱤭","pi":"㓟㨢㨽㮰㯅㱟㳪㵨㼰㿙䏘䑀䑄䚰䚹䠘䡟䤏䤨䫌䫠䯱䰦䲹䴙䴽丕仳伓伾僻劈匹啤噼噽嚊嚭圮坯埤壀媲嫓屁岯崥庀悂憵批披抷揊擗旇朇枇毗毘毞淠潎澼炋焷狉狓琵甓疈疋疲痞癖皮睥砒磇礔礕秛秠稫篺紕纰罴羆翍耚肶脴脾腗膍芘苉蚍蚽蚾蜱螷蠯諀譬豼豾貔辟邳郫釽鈚鈹鉟銔銢錃錍铍闢阰陴霹駓髬魮魾鮍鲏鴄鵧鷿鸊鼙𠜱𠡄𠨸𠪮𠯔𠯭𠵬𠹦𡊝𡛘𡛡𡦟𡲮𡶌𡺮𢇳𢓖𢞗𢰘𢱧𢻹𢾇𢾱𣓋𣔬𣖰𣢋𣪉𣬉𣬮𣬼𣹚𣹮𤂃𤖿𤘢𤘤𤘹𤚪𤬭𤱍𤴣𤷒𤼜𤿇𤿎𤿐𥀘𥔁𥤻𥯡𦀘𦃋𦊁𦘩𦘲𦤢𦨭𦰽𦳈𦹽𧑜𧓎𧧺𧪫𧲺𧳼𧴉𧾑𨈚𨐴𨑜𨤽𨧦𨲐𨵡𨵩𨸆𨺤𨻀𩔙𩗫𩜰𩣚𩫫𪄆𪇊𪉔𪊕𪌈𪖞𪛎𬬫𬬲𬳵","pian":"㓲㛹㸤㼐㾫䏒䮁偏囨媥楄楩片犏篇翩胼腁覑諚諞谝貵賆跰蹁鍂駢騈騗騙骈骗骿魸鶣𠯯𠷊𡎚𢉞𢐃𢕨𦳄𧍲𧡤𧱩𨂯𨲜𨵸𨸇𪘀𪚏","piao":"㬓㵱㹾㼼䏇䕯䴩僄剽勡嘌嫖彯徱慓旚殍漂犥瓢皫瞟票篻縹缥翲薸螵醥闝顠飃飄飘魒𠷻𡢱𡣋𣋳𣝐𣳭𦭼𧌠𧽤𨝓𨮬𩄷𩗏𩙒𩡦𩮳𪅃𪋖𪏫","pie":"䥕丿嫳撆撇暼氕瞥苤鐅𠟈𠢪𢳂𤮕𦒐𦗥𩓼𩠿𬭯","pin":"㡦㰋㺍䎙品嚬姘娦嫔嬪拼榀汖牝玭琕矉礗穦聘薲蠙貧贫頻顰频颦馪驞𠐺𠮰𡛞𢣐𢬵𢶳𣎳𥑓𥖶𦇖𧔪𧭹𧮝𨏞𩕵𩰗𬞟𲆳","ping":"㵗㺸㻂䀻䈂䍈䓑䛣䶄乒俜凭凴呯坪塀娉屏屛岼帡帲幈平慿憑枰檘泙洴涄淜焩玶瓶甁甹砯竮箳簈缾聠胓艵苹荓萍蓱蘋蚲蛢評评軿輧郱頩鮃鲆𠗥𠗦𡊞𢆟𢖊𤭔𤳊𥪁𥭢𥵪𦀔𦚓𦥚𦥤𦶊𧂋𧏑𨂲𩂾𩈚𩩍𪋋𪔾𪕒","po":"㗶㛘㧊㨇㩯䄸䍨䎅䞟䣪䣮䥽䨰䪖䪙䯙叵嘙坡婆尀岥岶敀昢桲櫇泼洦溌潑烞珀皤破砶笸粕蒪蔢謈迫鄱酦醗釙鉕鏺钋钷頗颇駊魄𠰐𠰼𠵳𠷑𠾌𡊟𡶆𡼃𡽠𢂤𢱨𢶉𣍸𣬚𣲳𤀪𤖼𤝯𤽌𥗟𥬒𥵜𥹖𦃡𦍁𦐦𦑀𦑵𦒟𦥭𦥲𦫔𦾕𦿍𧂉𧘟𧙅𧴤𧿽𨂩𨅅𨆵𨑝𨠓𨡩𨫁𨸭𩊀𩑼𩔈𩕏𩢘𩸿","pou":"㕻㧵㰴䬌䯽䳝剖咅哣娝婄抔抙捊掊犃箁裒錇𢒷𦵿𦺎𧠾𩔻𩚭","pu":"㒒㬥㯷㲫㹒㺪䈬䈻䑑䔕䗱䧤䮒䲕䴆仆僕匍噗圃圑圤埔墣巬巭扑撲擈攴攵普暜曝朴樸檏氆浦溥潽濮瀑炇烳獛璞瞨穙纀脯舖舗莆菐菩葡蒱蒲諩譜谱贌蹼酺鋪鏷鐠铺镤镨陠鯆𡜵𡰿𢈲𢼹𣋏𤆝𤗵𤰑𤾣𥐁𥐚𥛟𥣈𥼜𦬙𦮑𧙛𧦞𧭎𧱹𨁏𨛥𨽂𩂗𩑀𩪛𩯱𪋡𪒢𪔿𪖈𫚙𱾾","qi":"㒅㖢㞓㞚㟓㟚㟢㠌㣬㥓㩻㩽㫓㬤㯃㯦㰗㱦䀙䁈䁉䄎䄢䄫䅤䅲䉻䋯䌌䎢䏅䏌䏠䏿䐡䑴䒗䒻䓅䓫䔇䔾䗩䙄䚉䚍䞚䟄䟚䡋䡔䢀䣛䥓䧵䩓䫏䫔䭫䭬䭶䭼䰇䰴䱈䲬䳢䶒䶞七乞亓亝企俟倛僛其凄剘启呇呮咠唘唭啓啔啟嘁噐器圻埼夡奇契妻娸婍屺岂岐岓崎嵜帺弃忔忯悽愭慼慽憇憩懠戚捿掑摖攲斉斊旂旗晵暣期杞柒栔栖桤桼棄棊棋棨棲榿槭檱櫀欫欺歧气気氣汔汽沏泣淇淒湆湇漆濝炁猉玂玘琦琪璂甈畦疧盀盵矵砌碁碕碛碶磜磧磩祁祇祈祺禥竒簯簱籏粸紪綥綦綨綮綺緀緕纃绮缼罊耆肵脐臍艩芑芞芪萁萋萕葺蕲藄蘄蚑蚔蚚蛣蛴蜝蜞螧蟿蠐褀褄訖諆諬諿讫豈起跂踑蹊軝迄迉邔郪釮錡鏚锜闙霋頎颀騎騏騹骐骑鬐鬿魌鯕鰭鲯鳍鵸鶀鶈麒麡鼜齊齐𠀁𠁭𠅚𠊔𠎰𠐾𠓪𠔶𠧒𠫸𠴹𡍪𡖾𡢖𡦍𡪵𡫁𡷞𡹉𡹓𡹘𡹩𡺓𡺸𡻧𡻰𡽼𢁒𢍁𢍆𢍑𢔆𢔠𢜱𢞒𢢖𢢞𢩡𢴰𢺵𢺷𢻋𢻚𢻪𢾦𢾪𣉓𣏶𣔘𣛺𣫱𣯆𣶠𣾤𤘌𤪌𤳃𤳤𤷍𤹸𤺗𤼅𥀻𥄜𥇚𥉐𥉙𥉷𥉻𥌁𥓾𥔩𥖫𥤥𥫟𥷇𥼘𥽳𦄊𦈦𦔌𦖊𦘸𦙊𦚊𦛰𦡹𦧉𦧯𦩣𦪊𦫡𦭲𦸆𦸓𦸗𧇜𧋉𧌞𧎪𧒕𧓑𧕉𧘗𧘧𧙞𧙾𧚨𧠪𧡘𧡺𧯯𧰙𧻕𧼕𧼘𧽓𨁐𨉸𨊰𨑤𨒅𨙬𨙸𨞢𨥦𨪌𨱜𨵆𨸒𨸔𩉬𩒛𩒨𩠦𩥂𩦋𩧌𩨘𩨝𩲪𩳣𩴪𩷾𩹵𩺲𪀩𪂛𪄖𪄭𪅾𪒆𪒑𪔪𪗅𪗆𪗍𪗏𪙧𬨂𬮿𬱦𱍐𱣕𱹁","qia":"㓞㓣㓤㡊㤉䁍䂒䨐䯊䶝冾圶峠帢恰愘拤掐殎洽硈葜跒酠鞐髂𠕣𠜤𠜼𠝘𠝛𠳌𡘧𡤫𢮌𢼣𣁴𣘟𣣟𣨄𤫶𤵹𥎸𥦞𥴭𦝣𦸉𧩶𩥌𩩱𩮁𩷻𪘺𫈰","qian":"㐸㗔㜞㟻㦮㦿㧄㨜㩃㩮㩷㪠㯠㸫㹂䀒䁮䇂䇜䈤䈴䉦䊴䑶䕭䖍䙴䞿䥅䪈䭤䵖䵛乾仟仱伣佥俔倩偂傔僉儙兛凵刋前千嗛圱圲堑塹墘壍奷婜媊嬱孅孯岍岒嵌嵰忴悓悭愆慊慳扦扲拑拪掔掮揵搴撁攐攑攓杄棈椠榩槏槧橬檶櫏欠欦歉歬汘汧浅淺潛潜濳灊牵牽瓩皘竏签箝箞篏篟簽籖籤粁綪縴繾缱羬肷脥膁臤芊芡茜茾蒨蔳蕁虔蚈蜸褰諐謙譴谦谴谸軡輤迁遣遷釺鈆鈐鉗鉛銭錢鎆鏲鑓钎钤钱钳铅阡雃靬韆顅騚騝騫骞鬜鬝鰜鰬鵮鹐黔黚𠀼𠊭𠋵𠑲𠔺𠠃𠢍𠬾𠳋𠷁𡒌𢁮𢂺𢃘𢃥𢋔𢌍𢍱𢜩𢧥𢮄𣍰𣓅𣖳𣘝𣟋𣢖𣢬𣢲𣹥𤠿𤿷𥏥𥔮𥜴𥦃𥧬𥮒𥱺𥲢𥳐𥴤𥷪𦂒𦅋𦖎𦴑𦼓𧃑𧘜𧚫𧛓𧟑𧢞𧣑𧥛𧪯𧮮𧮽𧲀𧽐𨐋𨐩𨓲𨗦𨜻𨝍𨥞𨦄𨨘𨰂𨱫𨺩𨺫𨽨𩋆𩑳𩒣𩨃𩨊𩨓𩪢𩬚𪇇𪈇𪉻𪘦𬘬𰀡𲄨𲌞","qiang":"㛨㩖㳾㾤䤌䵁丬呛唴嗆嗴墏墙墻嫱嬙嶈廧強强戕戗戧抢搶斨枪椌槍樯檣溬漒炝熗牄牆猐獇玱瑲篬繈繦羌羗羟羥羫羻腔艢蔃蔷薔蘠蜣襁謒跄蹌蹡錆鎗鏘鏹锖锵镪𡠥𡬎𡸤𡺛𢈵𢏄𢐩𢧅𣫝𤕽𥇉𥓌𥴻𥶑𦯤𦳟𦷦𧇞𧖑𧭚𧱡𧽩𨄚𨶆𩣼𩩝𩼒𩿄𪁸𪎞𪙎𬧀𮠞𲌡","qiao":"㚁㚽㝯㡑㢗㤍㴥䀉䂪䂭䃝䆻䇌䎗䩌䫞䯨䱁䲾䵲乔侨俏僑僺劁喬嘺墝墽嫶峭嵪巧帩幧悄愀憔撬撽敲桥槗樵橇橋殻毃燆犞癄瞧硗硚磽礄窍竅繑缲翘翹荍荞菬蕎藮誚譙诮谯趫趬跷踍蹺躈郻鄡鄥釥鍫鍬鐈鐰锹陗鞒鞘鞩鞽韒頝顦骹髚髜𠏖𠿕𡌔𡩇𡰐𡰑𡺘𢄹𢐟𢘟𢩨𢮉𢶡𢻤𢿣𣂇𣒆𣖄𣜽𣦜𣯹𣹝𣺰𥁢𥉾𥟅𥹶𦢺𧄍𧣌𨃤𨅣𨜍𨜑𨝱𨞶𨸑𩖇𩨟𪑊𱂻","qie":"㓶㗫㚗㛍㛗㤲㥦㹤㼤㾀㾜䟙䤿䦧且切匧厒妾怯悏惬愜挈朅洯淁癿穕窃竊笡箧篋籡緁聺苆藒蛪踥郄鍥鐑锲鯜𠀃𠁠𠋧𠩂𠲵𡂠𡐤𡛠𡝍𡶐𢲶𢺅𣠺𤴼𤷾𥕑𥪵𥿚𦆍𦼰𦿋𧑨𧚪𧫕𧻘𧻧𨄊𨉪𨖰𨚧𩣴𪑗𪙌𱞝","qin":"㓎㕋㘦㝲㞬㢙㤈㩒㪁㮗㾛㾣䃢䈜䔷䜷䦦䰼亲侵勤吢吣唚嗪噙坅埁媇嫀寑寝寢寴嵚嶔庈慬懃懄抋捦揿搇撳擒斳昑梫檎欽沁溱澿瀙珡琴琹瘽禽秦笉綅耹芩芹菣菦菳藽蚙螓螼蠄衾親誛赾鈙鈫鋟钦锓雂靲顉駸骎鬵鮼鳹鵭𠓿𠔎𠖶𠘅𠜘𠦎𠻨𡫧𡵑𡹢𢫲𢱶𣆲𣖯𣜣𣢐𣨠𣪄𤙋𤚩𤥓𤴽𤵂𤿳𥍯𥎊𥎡𥘋𥱧𥵧𦧋𦯈𧯃𧼒𧾏𨙽𨛣𨾰𩂈𩎖𩐙𩓒𩔝𩔟𪒭𪒯𪙟𱰤","qing":"㩩㯳㵾㷫䋜䔛䞍䡖䨝䯧䲔倾傾儬凊剠勍卿圊埥夝寈庆庼廎情慶掅擎擏晴暒棾樈檠檾櫦殑殸氢氫氰淸清漀濪甠硘碃磬箐罄苘葝蜻請謦请輕轻郬鑋靑青靘頃顷鲭黥𠑴𠗝𠨍𡄇𡄔𡲀𢹃𣩜𣫨𤭩𥃟𥱨𧕙𧖪𨆪𨓷𨻺𩇝𩇟𩑭𩒵𩔥𩗼𩷏𩽡𪄈𪏅","qiong":"㑋㒌㧭㮪㷀㼇䅃䆳䊄䓖䛪䠻儝卭宆惸憌桏橩焪焭煢熍琼璚瓊瓗睘瞏穷穹窮竆笻筇舼芎茕藑藭蛩蛬赹跫邛銎𠌖𠤊𡊼𡞦𡦃𡸕𡺺𢞏𢮍𢶇𣇬𣋶𣑦𣜧𣶆𤢶𤤑𤤶𥑎𥑱𥨪𥳎𦦧𦨰𦭭𦾵𨀯𨍶𩑓𩢽𩨯𩬛𩬰𪀛","qiu":"㐀㕤㚱㛏㞗㟈㤹㥢㧨㭝㳋㷕㺫䆋䊵䎿䐐䜪䟬䟵䠓䠗䣇䤛䨂䲡丘丠俅叴唒囚坵媝崷巯巰恘扏搝梂楸殏毬求汓泅浗渞湭煪犰玌球璆皳盚秋秌穐篍糗紌絿緧肍莍萩蓲蘒虬虯蚯蛷蝤蝵蟗蠤裘觓觩訄訅賕赇趥逎逑遒邱酋醔釚釻銶鞦鞧鮂鯄鰌鰍鰽鳅鶖鹙鼽龝𠀉𠗈𠰋𡊣𡲚𢈝𢘄𢛃𢦎𣧝𣭳𤕾𤞰𥔻𥥽𥫷𥭑𦦄𦬖𦰪𧇸𧏋𧔭𧣕𧤕𧲰𧺤𧻁𧻱𨍊𨒊𨕦𨟽𨱇𨲒𨺧𩈸𩒮𩔕𩗕𩝠𩵍𩾁𪍗𪖛𪚺𬓫𱗕","qu":"㖆㘗㜹㠊㣄㧁㫢㭕㯫㰦㲘㸖㻃䁦䂂䆽䈌䋧䒧䒼䓚䓛䖦䝣䞤䟊䠐䢗䧢䵶䶚伹佉佢刞劬匤区區厺去取呿唟坥娶屈岖岨岴嶇忂憈戵抾敺斪曲朐欋氍浀淭渠灈璖璩癯瞿磲祛竘竬筁籧粬紶絇翑耝胊胠臞菃葋蕖蘧蛆蛐蝺螶蟝蠷蠼衐衢袪覰覷覻觑詓詘誳诎趋趣趨躣躯軀軥迲鑺镼閴闃阒阹駆駈驅驱髷魼鰸鱋鴝鸜鸲麮麯麴麹黢鼁鼩齲龋𠇯𠍲𠏛𠣪𡟥𡡥𡱅𡱺𡲰𡳆𢌄𢌷𢎖𢦌𢴮𢼰𣖪𣮈𣯸𣰋𣰠𣰡𣰻𤖬𤙏𤨎𥃔𥗫𥧻𥬔𥶶𥺷𥽧𦄽𦐛𦔬𦕙𦗛𦛕𦛱𦣒𦸶𦼫𧄒𧉧𧊛𧌑𧐅𧕎𧝔𧠢𧲵𧾱𧾶𨄅𨎶𨐣𨓭𨞙𨞳𨧱𨱊𨸟𨼫𨼽𩇐𩉿𩖷𩢳𩣹𩧘𩪍𩴹𩵅𩽩𩿟𩿥𩿩𪀊𪁖𪄊𪆂𪉌𪋄𪌆𪌬𪍸𪛃𪨰𬸱","quan":"㒰㒽㟫䀬䄐䅚䊎䌯䑏䟒䠰佺全券劝勧勸啳圈圏埢奍姾婘孉峑巏弮恮悛惓拳搼权棬椦楾権權汱泉洤湶烇牶牷犈犬犭瑔畎痊硂筌絟綣縓绻荃葲虇蜷蠸觠詮诠跧踡輇辁醛銓鐉铨闎韏顴颧駩騡鬈鰁鳈齤𠛮𠤹𠥙𡇮𡈉𡙅𡙐𡰝𡴔𡺟𡿨𢍕𢎠𢑆𢔑𣍴𣸋𤜍𤥷𤬠𤰝𤷄𥁸𥤊𥹳𦋓𦍅𦏮𦓰𦨚𧈾𧍭𧸾𨛈𨜩𨟠𨨗𨩸𩓫𩘘𩜬𩧴𪈻𪐂","que":"㕁㩁㰌㱋㱿㲉㴶㹱㾡䇎䍳䦬䧿䲵却卻埆塙墧崅悫愨慤搉榷燩琷瘸皵硞确碏確碻礐礭缺蒛趞闋闕阕阙雀鵲鹊𠞗𡇱𡉉𢠬𣛵𣤇𣪹𤣅𤷽𤿋𤿩𤿵𥀎𥆸𥕹𥗙𥗮𥜵𥩢𧎯𧢩𧢭𨞩𨢜𨴊𨴒𨵗𩤈𩨭𩨷𩫠𪏈𪏨𪖀𬒈","qun":"㟒㪊㿏䭽囷夋宭峮帬羣群裙裠逡𡈀𢛕𣀄𤛭𤸷𦃢𦽖𨞗𩎗𩤁","ran":"㒄㚩㜣㲯㸐㾆㿵䎃䒣䔳䕼䖄䣸䤡䫇䳿冄冉呥嘫姌媣染橪然燃珃繎肰苒蒅蚦蚺衻袇袡髥髯𠊌𠤀𠯍𠱞𡖝𡜉𡜫𢓒𣰦𤙼𤡮𤱋𤲗𥀭𥣹𥣺𥬕𥳚𦫉𨹌𩃵𩢡𩧬𩶎𪓘𪓚𪚮","rang":"䉴䑋儴勷嚷壌壤懹攘瀼爙獽瓤禳穣穰纕蘘譲讓让躟鬤𢐿𣩽𣰶𤅑𤬥𤰂𥗝𧟄𨏛𨟚𩆶","rao":"㑱㹛娆嬈扰擾桡橈繞绕荛蕘襓遶隢饒饶𠒸𡈦𦪛𧳨𨇄","re":"惹热熱𢞇𤍠𤑄𧧏𩭿","ren":"㠴㣼㶵㸾䀔䇮䋕䌾䏕䚾䛘䭃人亻仁仞仭任刃刄壬妊姙屻岃忈忍忎扨朲杒栠栣梕棯牣祍秂秹稔紉紝絍綛纫纴肕腍芢荏荵葚衽袵訒認认讱躵軔轫鈓銋靭靱韌韧飪餁饪魜鵀𠯄𠲏𡰥𢆉𢇦𣅉𦍌𦏀𦬄𧥷𧴬𨉃𩑉𩠈𩵕𪔺","reng":"㭁㺱䄧䚮仍扔礽芿辸陾𠧟𠮨𠯷𠯹𣗐𥾋𧹈𨸐","ri":"䒤囸日釰鈤馹驲𡆸𡉭𤝍𦨙𱓰𲇰","rong":"㘇㝐㣑㭜㲓㲝㲨㺎㼸䇀䇯䈶䘬䠜䡆䡥䢇䤊䩸傇冗坈媶嫆嬫宂容峵嵘嵤嶸巆戎搈搑曧栄榕榮榵毧氄溶瀜烿熔爃狨瑢穁穃絨縙绒羢肜茙茸荣蓉蝾融螎蠑褣軵鎔镕駥髶𠞕𠰽𡊫𡊸𡖢𡦼𡫦𡭋𢦿𢫨𣭲𣮪𣯍𣯏𣯐𣰇𣲽𤘺𤘻𥎂𥎜𥑳𥨳𥬪𥼬𦔋𦗋𦗨𦶇𧉡𧎣𨉴𨉷𨋠𨌣𨍅𨍷𨒆𨲟𩍉𩎂𩚗𩮠𩼅𪃾𪕁𪕎𪗴𲆵","rou":"㽥䐓䧷䰆厹媃宍揉柔楺渘煣瑈瓇禸粈糅肉腬葇蝚蹂輮鍒鞣韖騥鰇鶔𠠐𡗑𢔟𥠊𦍭𨛶𪑶𱣑𲅂","ru":"㐵㦺㨎㹘㾒䄾䋈䞕䰰乳侞儒入嗕嚅如媷嬬孺嶿帤扖擩曘杁桇汝洳渪溽濡燸筎縟缛肗茹蒘蓐蕠薷蝡蠕袽褥襦辱邚鄏醹銣铷顬颥鱬鳰鴑鴽𠟺𡄲𡜃𡜚𡫽𡮚𢖵𢛚𣖹𣚐𣭠𣯋𣽈𣽉𥙦𥞚𦤊𦭰𦳾𦷸𧊟𨚴𨨜𩄋𩍥𩱨𩶫𩶯𩸐𪏮𪑾","rua":"挼","ruan":"㓴㮕㼱㽭䎡䓴䙇䞂䪭偄堧壖媆撋朊瑌瓀碝礝緛耎軟輭软阮𠤦𢘧𢡵𢱾𣃅𣡗𣽳𤧠𤲬𥈇𥊶𥎀𥎘𥩗𥯬𦺾𨒩𨨰𨪳𨬔𩏈","rui":"㓹㢻㪫㲊䂱䄲䅑䇤䌼䓲䬐叡壡婑枘桵橤汭瑞甤睿緌繠芮蕊蕋蕤蘂蘃蚋蜹銳鋭锐𡯒𢣳𣛚𣬘𥳝𦼆𧄜𨧨𨳙𪏩𮉫","run":"㠈䏰䦞橍润潤瞤膶閏閠闰𠷀𥆧𨷎𩀋","ruo":"䐞偌叒嵶弱捼楉渃焫爇箬篛若蒻鄀鰙鰯鶸𤍽𤣼𦩸𧃪𨀝𨴚𱥣","sa":"㒎㚫㪪㽂䊛䙣䬃仨卅挱挲摋撒櫒泧洒潵灑脎萨薩虄訯躠鈒钑隡靸颯飒馺𠎷𠦃𠬙𠮿𠱡𠿓𡄳𡐥𡒁𢓔𢕬𢫬𢻨𣀯𣜂𣬬𥋌𥵯𥸗𥻦𦠿𦻅𦼧𧀕𧭝𨃛𨆂𨐖𨷆𩆅𩎕𩐅𩗉𩗞𩨞𱗂","sai":"㗷㘔㩙䈢䚡䰄僿嗮嘥噻塞愢揌毢毸簺腮賽赛顋鰓鳃𡬉𦞫𪃄","san":"㤾㧲㪔㪚䈀䉈䊉䫅䫩三仐伞俕傘厁叁壭帴弎散橵毵毶毿犙糁糂糝糣糤繖鏒鏾閐饊馓鬖𡙘𢁘𢕕𣀧𣀫𣬛𣮠𥒬𦙱𦙸𦡨𦷻𦺻𧗋𧱆𧽾𨸃𩀲𩀼𩞀𩯑","sang":"䘮䡦䫙丧喪嗓搡桑桒槡磉褬鎟顙颡𡕏𡠏𣉕𣊝𣞙𤸯𥔫𦅇𦟄𧍨𨢆𩐷𩦌𩺞𪔬𱮒","sao":"㛮㥰㲧㿋䕅埽嫂慅扫掃掻搔氉溞瘙矂繅缫臊螦騒騷骚髞鰠鱢鳋𠋺𢔳𢜶𢠡𢤁𢮞𣉔𣰕𤠘𤢖𥰱𦏛𦕏𦞣𦺋𦾘𧂩𧑫𧖠𨃣𨧪𨪊𩙈𩙰𩫦𩮚𪍻𱝄","se":"㒊㥶㱇㻭䉢䔼䨛啬嗇懎擌栜歮歰洓涩渋澀澁濇濏瀒琗瑟璱瘷穑穡穯繬色譅轖銫鏼铯閪雭飋𠎸𠟦𠟩𠢳𠵭𠽼𠿗𡫟𡵶𢀋𢃢𢡉𣚟𣽤𤁧𤖗𤛷𤾿𥈽𥱁𥷹𥻨𦆄𦐅𧈈𧒓𧒗𧨷𨆙𩃑𩄜𩇣𩊯𩍙𩏫𩕡𩰙𬈧","sen":"森椮槮襂𣟹𧂅𩕌","seng":"䒏僧鬙𡬙","sha":"㠺㰱㰼㲚㵤㸺䈉䝊䤬䬊乷倽傻儍刹剎厦唦唼啑啥喢帹廈杀桬榝樧歃殺毮沙煞猀痧砂硰箑粆紗繌纱翜翣莎萐蔱裟鎩铩閯霎魦鯊鯋鲨𠍽𠚺𡺧𢅑𢇗𢩖𢶌𢼵𣉜𣓉𣛶𣡽𣣮𣣺𣲓𣲡𣶤𣻑𤍁𤑣𤟃𥈊𦀛𦔯𦔰𦕉𦩿𦭉𦱵𦾚𧋊𧏫𧫝𧲌𧳛𧻵𨖷𨘉𨪍𩊮𩮫𩵮𪄅𪌮","shai":"㩄㬠㴓䵘晒曬筛篩簁簛繺酾釃閷𢄌𧜁𨢦𩂃𩂝𩴇","shan":"㚒㣌㣣㨛㪎㪨㰑㴸㶒㺑䀐䄠䘰䚲䠾䡪䥇䦂䦅䱇䱉䴮傓僐删刪剡剼善嘇圸埏墠墡姍姗嬗山幓彡扇挻掞搧擅敾晱杉柵椫樿檆歚汕潬潸澘灗炶煔煽熌狦珊疝痁睒磰笘縿繕缮羴羶脠膳膻舢芟苫蟮蟺衫覢訕謆譱讪贍赡赸跚軕邖鄯釤銏鐥钐閃閊闪陕陝饍騸骟鯅鱓鱔鳝鿃𠚹𠫹𠿞𡟨𢒉𢒹𢕻𢩢𢫔𢿈𣆴𣓒𣖉𣧺𣩧𣪶𣲀𤇄𤊼𤮜𤺪𥄘𥈚𥊀𥔱𥰢𥸣𦍸𦎞𦏂𦘹𦳫𦶋𦺭𧎥𧛄𧛡𧧵𧨾𧭽𧲾𧴭𧷶𨁆𨏪𨝩𨝵𨹈𨹊𩁺𩆤𩆫𩌰𩟋𩦐𪍶𪑃𫮃𱖒","shang":"䵰䵼丄上伤傷商垧墒尙尚恦慯扄晌殇殤滳漡熵緔绱蔏螪裳觞觴謪賞贘赏鑜鞝鬺𠼬𤎘𤔚𤳈𤵼𥏫𧡮𧶜𨢩𨶼𩞃𩞧𪄲𬀷","shao":"㪢㲈㸛䈰䈾䏴䒚䔠䙼䬰劭勺卲哨娋少弰捎旓柖梢潲烧焼燒玿睄稍筲紹綤绍艄芍苕莦蕱蛸袑輎邵韶颵髾鮹𠣫𠧙𠷃𡡏𢦽𢼼𢾐𤉎𤱠𥙬𥳓𥵦𦄏𦓴𦯐𦿃𧣪𧳹𨈘𨙹𨛍𨱭𨲆𩬏","she":"㓭㴇㵃䀅䄕䜓䞌䠶䤮䬷佘厍厙奢射弽慑慴懾捨摂摄摵攝檨欇歙涉涻渉滠灄猞畬畲社舌舍舎蔎虵蛇蛥蠂設设賒賖赊赦輋韘騇麝𠋞𠪣𠴯𠾏𡄢𢉃𢗭𢶅𣝒𣣭𣸚𤙱𤠭𤺔𥁹𥍉𥔡𥝀𥿞𦁗𦯬𦴍𦼢𧉮𧮿𧵳𨝫𨣍𩂨𩂴𩙝𩩗𩮐𪨶","shen":"㑗㕥㚞㚨㜪㮱㰂㰮㵕㾕䅸䆦䯂䰠什伸侁侺兟呻哂堔妽姺娠婶嬸审宷審屾峷弞愼慎扟敒昚曋曑柛棽椹榊氠沈涁深渖渗滲瀋燊珅甚甡甧申瘆瘮眒眘瞫矤矧砷神祳穼籶籸紳绅罙罧肾胂脤腎莘葠蓡蔘薓蜃蜄裑覾訠訷詵諗讅诜谂谉身邥鋠頣駪魫鯓鯵鰰鰺鲹鵢𠂧𠃫𠗿𠘆𠻝𡖬𡼬𢈇𢈯𢊖𢊲𢏎𢏦𢘊𢸙𣇗𣔗𣘘𣘲𣿇𤏗𤕊𤶴𥆣𥏖𥥍𥥿𥬐𥳱𥸬𦌀𦐹𦕽𦜊𦜜𦸂𦸯𦺷𧀯𧢹𨊘𨐍𨐔𨐕𨝐𨞲𨴐𩉼𩶇𩺵𬬹𬳽𱵁𱽖","sheng":"㗂㮐㱡㼳㾪䁞䚇䞉䪿䱆䲼䴤偗剩剰勝升呏圣墭声嵊憴斘昇晠曻枡栍榺橳殅泩渻湦焺牲狌珄琞生甥盛省眚竔笙縄繩绳聖聲胜苼蕂譝貹賸鉎鍟阩陞陹鵿鼪𠇷𠓸𠓽𠴢𡞞𡨽𢦑𣢡𣬺𤚣𤯡𥘥𥟎𦔄𦕡𦖞𦛙𦩱𦳗𧍖𧡶𧪝𧿘𨁠𨕻𨚱𨜜𨲓𨵥𩍋𪅻𱰎","shi":"㒾㔺㕜㖷㱁㳏㵓㸷㹝㹬㹷䁺䂖䂠䄷䈕䊓䌤䌳䏉䏡䒨䖨䗐䙾䛈䟗䤭䤱䦹䩃䭄䲽䴓䶡世丗乨乭亊事仕佦使侍兘冟势勢匙十卋叓史呞呩嗜噬埘塒士失奭始姼媞嬕实実室宩寔實尸屍屎峕崼嵵市师師式弑弒徥忕恀恃戺拭拾揓施时旹是昰時枾柹柿栻榁榯氏浉湜湤湿溡溮溼澨濕炻烒煶狮獅瑡眂眎眡睗矢石示礻祏竍笶筮篒簭籂絁舐舓莳葹蒒蒔蓍虱蚀蝕蝨螫褷襫襹視视觢試詩誓諟諡謚識识试诗谥豉豕貰贳軾轼辻适逝遈適遾邿釈释釋釶鈰鉂鉃鉇鉈鉐鉽銴鍦铈食飠飾餙餝饣饰駛驶鮖鯴鰘鰣鰤鲥鲺鳲鳾鶳鸤鼫鼭𠀍𠁗𠇳𠓤𠘪𠡥𠥿𠩔𠯰𠰚𠰴𠷇𡀗𡂓𡅵𡉸𡚼𡟕𡠋𡣪𡫵𡰯𡱁𡶈𡷈𡺔𢀕𢁓𢂑𢃰𢝬𢧏𢨝𢺿𢻘𢻫𢼉𢼊𣁒𣆘𣏚𣤘𣧚𣬐𤆰𤉏𤑦𤖻𤜣𤢼𤯄𤯜𤸤𤹌𥅔𥅞𥇲𥍸𥐘𥑏𥛨𥜰𥥥𥫴𥫽𥰰𥼶𥿅𦌿𦒈𦔂𦚨𦰯𦳊𦿇𧄹𧊖𧍀𧜂𧝊𧞲𧠜𧠡𧧅𧩹𧳅𧵋𧻸𨒍𨒧𨙩𨟂𨱡𨴯𨸝𨽄𩋡𩒂𩗎𩛌𩛏𩥐𩬭𩭐𩰢𪀔𪊢𪓻𪓿𪗧𫄟𫚕𬤊𱛬","shou":"㖟㝊㥅㧃䛵䭭兽収受售垨壽夀守寿手扌授收涭狩獣獸痩瘦綬绶膄艏鏉首龵𠈅𠱔𡭮𣒻𤙘𤚔𤱜𥅪𥅷𥙰𥨝𥾹𦣻𧈙𧌅𧚯𧜃𧤙𧯼𧵃𨞪𨱒𩠶𩴍𪈀𫜷","shu":"㑐㒔㛸㜐㡏㣽㫹㯮㵂㶖㷂㸡㻿㼡㽰㾁䃞䉀䑕䘤䜹䝂䝪䞖䠼䢞䢤䨹䩱䱙䴰书侸倏倐儵叔咰塾墅姝婌孰尌尗属屬庶庻怷恕戍抒捒掓摅攄数數暏暑曙書朮术束杸枢树梳樞樹橾殊殳毹毺沭淑漱潄潻澍濖瀭焂熟瑹璹疎疏癙秫竖竪糬紓絉綀纾署腧舒荗菽蒁蔬薥薯藷虪蜀蠴術裋襡襩豎贖赎跾踈軗輸输述鄃鉥錰鏣陎隃鮛鱪鱰鵨鶐黍鼠鼡𠊪𠐊𠘧𠙎𠲌𠾢𡂡𡊍𡒒𡔪𡣈𡤽𡦛𡧔𡱆𢋂𢞣𢠫𢧇𣀻𣉛𣏗𣤯𣰿𣻚𤍓𤕟𤗪𤘷𤞉𤱐𤴙𤻃𥍝𥣋𥳕𥿇𦈌𦈷𦍄𦐣𦒶𦠦𦤂𦶕𦺗𦺪𧄔𧇝𧑓𧒑𧗱𧞀𧞫𧠣𧼯𨁀𨅒𨐅𨔦𨛭𨶝𨷙𨽉𩛅𩢻𩳅𩷌𩾈𪅰𪌶𪐧𬬸","shua":"㕞刷唰耍誜𠛚𤔙𩈥𩉆𩤤𱝃","shuai":"㲤䢦卛帅帥摔甩蟀衰𠌭𢕅𢕑𣘚𣼧𤠠𤸬𤺀𧍓𧗿𧜠𨄮𩘱","shuan":"䧠拴栓涮腨閂闩𡭐𢩠𢮛𣔫𣟴𣠸𤅲𦺲𨄔𨏉","shuang":"㕠㦼䉶䌮䔪䗮䝄䫪双塽孀孇慡樉欆漺灀爽礵縔艭鏯雙霜騻驦骦鷞鸘鹴𠗾𡑽𥡠𥱶𥲚𦄍𦆌𧄐𧕟𧕺𧴅𨇯𩅪𩆿𩽧𫘭𮭪","shui":"㥨㽷䬽䭨䳠帨水氵氺涗涚睡祱稅税脽裞誰谁閖𠻜𡯑𡱊𢇤𢏅𤆙𥌘𥫸𦙙𦣢𧀣𨓚𨿠𩟥𩩞","shun":"㥧䀢䀵䑞䴄吮橓瞚瞬舜蕣順顺鬊𨝜𨺠","shuo":"㮶䀥䁻哾妁搠朔槊欶烁爍獡矟硕碩箾蒴說説说鎙鑠铄𠲾𠲿𣀝𣝇𣷥𣸛𣻘𤡯𤢴𥌞𦂗𦃗𦋞𨨺𩟧𪎒","si":"㒋㕽㚶㟃㠼㣈㭒㴲㸻㹑㺇㺨㽄䇁䇃䎣䏤䔮䡳䦙䫢䲉丝亖佀価俬儩兕凘厮厶司咝嗣嘶噝四姒娰媤孠寺巳廝思恖撕斯杫柶楒榹死汜泀泗泤洍涘澌瀃燍牭磃祀禗禠禩私竢笥籭糹絲緦纟缌罳耜肂肆蕬蕼虒蛳蜤螄蟖蟴覗貄釲鈶鈻鉰銯鋖鐁锶颸飔飤飼饲駟騦驷鷥鸶鼶𠀓𠋡𠖓𠭈𠳎𡡒𢊀𢍭𢛥𢠹𢦲𣂖𣙼𣚄𣣑𣩠𣱻𣽷𤆟𤣵𤱸𥄶𥐀𥒲𥕶𥙉𥝠𥠱𥯨𥹊𦇲𦇵𦭡𦮺𦸷𦽕𧀚𧀩𧝤𧣛𧱅𧳙𨮭𨽼𩅰𩆵𩵗𩸟𩺛𪆁𪆗𪊍𪕳𪖉𬢊𱎠似","song":"㞞㣝㧐㨦㩳㮸䉥䛦䜬䢠䯳䯷倯傱凇娀宋崧嵩嵷庺忪怂悚愯慫憽松枀枩柗梥楤檧淞濍硹竦耸聳菘蜙訟誦讼诵送鍶鎹頌颂餸駷鬆𠳼𡇝𡷽𡾼𢓣𢔋𢖗𢤄𢱤𣚜𣽫𤾥𥳺𦯕𦷴𧊕𧌻𨠤𨱛𨱿𨴏𩃍𩃭𩠌𩩺𪀚𪨊𲆩","sou":"㛐㟬䈭䈹䉤䏂䐹䑹䗏䤹䩳䬒䮟䱸傁凁叜叟嗖嗽嗾廀廋捜搜摉摗擞擻櫢溲獀瘶瞍籔艘蒐蓃薮藪螋鄋醙鎪锼颼颾飕餿馊騪𠋢𠌞𠌟𠘂𠝬𠪇𡠼𡣂𢲷𢴼𣔱𣮬𣯜𤕇𥈟𥖻𥯪𦺌𧔅𧳶𧽏𨡻𨤇𨺦𩗣𩘠𩙫𩨄𩮃𩮶𩮸","su":"㑉㑛㓘㔄㕖㜚㝛㢝㨞㪩㬘㯈㲞㴋㴑㴼䃤䅇䌚䎘䏋䑿䔎䛾䥔䲆俗傃僳嗉囌塐塑夙嫊宿愫愬憟梀榡樎樕橚櫯殐泝洬涑溯溸潚潥玊珟璛甦碿稣穌窣簌粛粟素縤肃肅膆苏莤蔌藗蘇蘓觫訴謖诉谡趚蹜速遡遬酥鋉餗驌骕鯂鱐鷫鹔𠐍𡎮𡖯𢋈𢎎𢖏𢚑𢢒𢸫𣝝𣩷𣫎𣯼𣶘𣷶𣿈𤌂𤛝𤠚𤡃𤢂𤢘𤤐𤥔𤭴𤸮𤼀𦌉𦌊𦎄𧀌𧐁𧐒𧐴𧔖𧜦𧞺𧥆𧩝𧺷𧼭𧽷𨱈𩐫𩐼𩘰𩘹𩙨𩝥𩲵𩳒𪁽𪄑𪅄𪋝𪌔𪍛𪐮𪖶𫂙𫗧","suan":"䝜匴狻痠祘笇筭算蒜酸𠥘𤶤𥳪𥴵𦾹𨠡𩆑𩈲𪘑𪘝","sui":"㒸㞸㥞㴚㵦㻟㻪㻽䅗䉌䍁䔹䜔䠔䡵䢫䥙䧌䪎䭉䯝亗倠哸埣夊嬘岁嵗旞檖歲歳浽滖澻濉瀡煫熣燧璲瓍眭睟睢砕碎祟禭穂穗穟綏繀繐繸绥膸芕荽荾葰虽襚誶譢谇賥遀遂邃鐆鐩隋随隧隨雖鞖韢髄髓𠌱𠕸𠨌𠭥𡑞𡝓𡶣𡷼𡹖𡻕𢅕𢇥𢈼𢒱𢟩𣄧𣩡𣮄𣯯𤡪𤬫𤯖𤻄𥊴𥕸𥤼𥴦𥶻𦃒𦄑𦅵𦇀𦉎𦵭𦸏𧃚𧈧𧌢𧡏𧨧𧲈𧸙𨆏𨣢𨷃𨾡𨾬𩃃𩌩𩍚𩎰𩏘𩏚𩏲𩗶𩙇𩝌𩞅𩮴𫟦𬭼","sun":"㔼㦏䁚䐣孙孫损損搎榫槂狲猻笋筍箰簨荪蓀蕵薞鎨隼飧飱鶽𠣬𣕍𦠆𧎤","suo":"㛖㪽㮦䂹䅴䈗䐝䓾䔋䖛䞆䞽䣔䯯䵀乺傞唆唢嗍嗦嗩娑惢所摍暛桫梭溑溹琐琑瑣璅睃簑簔索縮缩羧莏蓑蜶褨趖逤鎈鎍鎖鎻鏁锁髿鮻𠈱𠋲𠗼𠘺𠝿𠞯𠩄𠱗𡩡𡱳𢘿𢚭𢱡𢱢𢷾𣒹𣯌𤀤𤸴𤺫𥁲𥆝𥇇𥔭𥰼𦅊𦟱𦵫𧎫𧎳𧛻𧨀𧴪𧴲𨻈𨻨𩋝𩌆𩌈𩌢𩘝𩙭𩡾𩪈𩮛𩹳𪍌𪍔𪍟𪍨𫠦𬭲𭕆","ta":"㒓㗳㛥㣛㣵㧺㭼㯓㯚㳠㹺㺚㿹䂿䈋䈳䌈䍇䍝䎓䑜䑽䓠䜚䳴䵬䶀䶁他侤咜嚃嚺塌塔墖她它崉挞搨撻榙榻橽毾涾溚溻澾濌牠狧獭獺祂禢褟誻譶趿踏蹋蹹躢遝遢錔铊闒闥闧闼鞜鞳鮙鰨鳎鿎𠉂𠴲𠷍𡌩𢃕𢞠𢺉𣗶𣝋𣥂𣥷𣯚𤄥𤒻𤛣𤠐𤠟𤿽𥗓𦈖𦍒𦐇𦑇𦑲𦑶𦑼𦧛𦧞𦧟𦧥𦧱𦨎𦪙𦭟𦱆𦶑𦾽𧌏𧔣𧖆𧪦𧮑𨃚𨆰𨌭𨓬𨔯𨙎𨰏𨵝𨶀𨸉𩋅𩌇𩌉𩌐𩌘𩎽𩥑𩨌𩫊𩷽𩺗𪂌𪔕𪘁","tai":"㑷㒗㘆㙵㣍㥭㬃㷘㸀䈚䑓䣭儓冭台囼坮太夳嬯孡忲态態抬擡旲枱檯汰泰溙炱炲燤箈籉粏肽胎臺舦苔菭薹跆邰酞鈦钛颱駘鮐鲐𡇷𡒢𢖤𣣿𤗿𦒰𧉑𧉟𧭏𧮼𩬠𩿡𪐥𪒴","tan":"㘱㛶㨏㫜㲜㲭㳩㴂㵅㷋㽎㽑䃪䆱䉡䊤䏙䐺䑙䕊䗊䜖䞡䦔倓傝僋叹嗿嘆坍坛坦埮墰墵壇壜婒忐怹惔憛憳憻探摊擹攤昙暺曇榃檀歎毯湠滩潭灘炭燂璮痑痰瘫癱碳磹罈罎舑舕菼藫袒襢覃談譚譠谈谭貚貪賧贪郯醈醓醰鉭錟钽锬顃餤𠫶𠻪𡅄𡊨𢅀𢇧𢇰𣁗𣞔𣢌𣴽𣵢𣸙𣼚𣽯𤎥𤐔𥩒𥰨𥹠𥼟𥼮𦃖𦌪𦗡𦙇𦧏𦧴𦨸𦸁𦼎𧂇𧣁𧣹𧥞𧫿𧰘𧺟𧽼𨁴𨂞𨅍𨝸𨡍𨣕𩑰𩒢𩖖𩠽𩡄𩡝𩤞𩪺𪉧𪍵𱮜","tang":"㑽㒉㓥㙶㜍㭻㲥㼒㼺㿩䅯䉎䌅䕋䞶䟖䠀䣘䧜伖倘偒傏傥儻劏唐啺嘡坣堂塘帑戃搪摥曭棠榶樘橖汤淌湯溏漟烫煻燙爣瑭矘磄禟篖糃糖糛羰耥膅膛蓎薚蝪螗螳赯趟踼蹚躺鄌醣鎕鎲鏜鐋钂铴镋镗闛隚鞺餳餹饄饧鶶鼞𠗶𠢃𠹔𡿓𢠵𢴳𢻿𣎲𣙟𤚫𤠯𤾉𥋡𦪀𦳝𧱵𨆉𨉱𨌩𨍴𨎋𨎖𨲗𨶈𩘜𩥁𩹶𪕹𱿫𲉅","tao":"㚐㣠㫦㹗䀞䄻䈱䑬䚯䛌䛬䤾䬞䵚匋咷啕夲套嫍幍弢慆掏搯桃梼槄檮洮涛淘滔濤瑫祹絛綯縚縧绦绹萄蜪裪討詜謟讨轁迯逃醄鋾錭陶鞀鞉鞱韜韬飸饀饕駣騊鼗𠇏𠓝𠗆𠚜𠞞𠬢𡍒𡺫𢔇𣨔𣰺𣺮𤘸𤙎𤚟𤴻𤵟𥰜𦍷𦺰𨌨𨡒𩎢𩏾𩘿𩙧𩛽𩥅𩹴𪌼𫘦","te":"㥂㧹忑忒慝特螣蟘貣鋱铽𠈸𢘋𣘱𤙰𥊸𥌩𫋌","teng":"䒅䕨䠮䲍䲢儯幐滕漛熥疼痋籐籘縢腾膯藤虅誊謄邆霯駦騰驣鰧鼟𢚺𢟱𢥂𣽨𤃶𤳘𤹤𥉋𦡪𦪝𦫀𧈜𧭔𨃗𩩻𩴝𪒿𪔶","ti":"㔸㖒㗣㡗㣢㬱㯩䅠䌡䎮䔶䖙䙗䚣䛱䢰䨑䪆䬫䬾䯜䱱䴘䶏䶑体倜偍剃剔厗啼嗁嚏嚔屉屜崹徲悌悐惕惖惿戻挮掦提揥擿替朑梯楴歒殢洟涕漽瑅瓋碮禵稊笹籊綈緹绨缇罤苐荑蕛薙蝭裼褅褆謕趧趯踢蹄蹏躰軆逖逷遆醍銻鍗锑題题騠骵體髰鬀鮧鮷鯷鳀鴺鵜鶗鶙鷈鷉鷤鹈𠞄𡥩𡰎𡲕𡲿𡸑𢝹𢞖𢧑𢱦𢳓𣄍𣈡𣉆𣖅𣖸𣜹𣤖𣧂𣸒𣹲𤗘𤗢𤚢𤟥𤟾𤭌𥉈𥉘𥡦𥫵𥳳𥶛𦌢𦻀𧀠𧀰𧋘𧔩𧙣𧛒𧝆𧝐𧡨𧨱𧼮𨁃𨔛𨠏𨪉𨲎𨲞𨴼𩋣𩓂𩛑𩛶𩝊𩤽𩬲𩮜𩿷𪍲𪕩𪖦𫘨𫛸","tian":"㐁㖭㙉㥏㧂㬲㮇㶺䀖䄼䄽䋬䐌䑚䚶䟧䠄䡒䡘䥖䧃倎兲唺塡填天婖屇忝恬悿掭搷晪殄沺淟添湉琠璳甛甜田畋畑畠痶盷睓睼碵磌窴緂胋腆舔舚菾覥觍賟酟鈿錪鍩闐阗靔靝靦餂鴫鷆鷏黇鿬𠗘𡒧𡙒𢇶𢓍𣊖𤘠𤤦𤫞𤲖𥧑𥪌𥪧𥳫𥵶𦊊𦔿𦗀𦗁𦧒𦧖𦧝𦬞𦳇𧉂𧌎𧨩𧨸𧰊𧹖𨆁𨉾𨌈𨡁𨡏𨸱𨹻𩈍𩉁𩚣𪅉𪌩𪎾𱃺","tiao":"㟘㬸㸠䒒䖺䟭䠷䩦䯾䱔佻嬥宨岧岹庣恌挑斢旫晀朓条條樤眺祒祧窕窱笤粜糶絩聎脁芀萔蓚蓨蜩螩覜誂趒跳迢鋚鎥鞗髫鯈鰷鲦齠龆𠛪𠤺𠧪𡠊𡯿𡳏𢈄𢓝𢖈𢳙𢺫𣂁𣂥𣒼𣟐𣬸𥎺𥶏𦩄𦴚𧌁𨋫𨾾𩲤𪌪𱸥","tie":"䥫䩞䴴䵿僣呫帖怗聑萜蛈貼贴銕鐡鐵铁飻餮驖鴩𢶋𤝓𦝒𦧢𦧤𪎋","ting":"㓅㹶㼗䅍䋼䗴䦐䯕䱓䵺亭侹停厅厛听圢娗婷嵉庁庭廰廳廷挺桯梃楟榳汀涏渟烃烴烶珽町甼筳綎耓聤聴聼聽脡艇艼莛葶蜓蝏誔諪邒閮霆鞓頲颋鼮𠄚𠕊𠘋𡈼𡔛𢬫𢽄𣂴𣄿𣉡𤗞𤘖𤱹𥆑𥑈𥥶𥫙𥴑𦉬𦐿𦕢𦗟𦝞𧓴𧖨𧰩𧶺𨁗𨉬𨊡𨓍𨳑𨳝𨸁𩆆𩐴𩑙𩒞𩨑𩹇𪊶𬘩","tong":"㛚㠉㠽㣚㤏㪌㸗㼧㼿䂈䆚䮵䳋䴀䶱仝佟僮勭同哃嗵囲峂峝庝彤恸慟憅捅晍曈朣桐桶樋橦氃浵潼炵烔燑犝狪獞痌痛眮瞳砼秱童筒筩粡統綂统膧茼蓪蚒衕詷赨通酮鉖鉵銅铜餇鮦鲖𠖄𡠙𡦜𢄟𢈉𢏕𢓘𢳟𣌾𣑸𣪯𣻢𤱇𥦁𥩌𥫂𥲆𦏆𦒍𦨴𧇌𧊚𧋒𧋚𧌝𧳆𧳿𨀜𨈹𨚯𨜳𨝯𨠌𩍅𩩅𩻡𪀭𪌢𫍣𱝉𲎆","tou":"㓱㖣㢏㪗㳆㼥䕱䚵䞬䟝䱏䵉亠偷偸头妵婾媮投敨紏綉緰蘣透鋀鍮钭頭飳骰黈𡇧𡷠𣛾𣪌𦈕𧺢𨔙𨯲𨱎𨷩𩜶𩿢𪁞𪉘𪌘𪎨","tu":"㟮㭸㻌㻠㻬㻯䅷䖘䛢䞮䠈䣄䣝䤅䩣䳜兎兔凃凸吐唋図图圕圖圗土圡堍堗塗宊屠峹嵞嶀庩廜徒怢悇捈捸揬梌汢涂涋湥潳痜瘏禿秃稌突筡腯荼莵菟葖蒤跿迌途酴釷鈯鋵鍎钍馟駼鵌鵚鵵鶟鷋鷵鼵𠊲𠞀𠟶𠫓𠫮𠳶𠸂𠻬𡇩𡸂𡺴𢝀𢬳𣅝𣈥𣒇𣔻𣥳𣲱𤙛𤟪𤷿𥂋𥥛𥧣𥨜𥯝𦔅𦝬𦩤𧛗𧧶𧳌𨑒𨙭𨝛𨨷𨱄𨴩𩣮𩥽𩸃𩾅𪉍𪑏𬳿𱖖","tuan":"㩛䊜䜝䝎䵊䵎䵯剸团団團彖慱抟摶槫檲湍湪漙煓猯疃篿糰褖貒鏄鷒鷻𡁴𢣎𣶣𤱝𧐕𧓘𧰄𧳩𧽢𨪒𩃘𩘯𩜵𩠊𩠹𪈋𪏖𬇘","tui":"㞂㞜㢈㢑㥆㱣㷟㾼㿉㿗䀃䅪侻俀僓娧尵弚推煺穨腿蓷藬蘈蛻蜕褪蹆蹪退隤頹頺頽颓駾骽魋𠺙𡯵𡷜𢉭𢊮𢓇𢟴𢠮𤍐𤗴𤸉𥢢𥲣𥶐𦖦𦜄𧆸𧝋𧮓𨆨𨌴𨗞𨘃𨽟𩓬𩘺𩙬𩳕𪨇𫵒𬓼𬯎","tun":"㖔㧷㩔㬿㹠㼊吞呑啍噋坉屯忳旽暾朜氽涒焞畽臀臋芚豘豚軘霕飩饨魨鲀黗𠭿𡉒𢞋𢥽𣋄𣵞𤶕𥴫𥸵𦍓𦜯𦜴𦟓𦟙𧑒𧰭𨁇𨙲𨧐𨳘𨹙𩂄𩖤𩷵𪌋𪎴𪎶𪏆𪑒","tuo":"㟎㸰㸱㼠㾃䍫䓕䜏䡐䪑䭾䰿䴱乇仛佗侂咃唾坨堶妥媠嫷岮庹彵托扡拓拕拖挩捝杔柝椭楕槖橐橢毤毻汑沰沱沲涶狏砣砤碢箨籜紽脫脱莌萚蘀袉袥託讬跅跎迱酡陀陁飥饦馱駄駝駞騨驒驝驮驼鬌魠鮀鰖鴕鵎鸵鼉鼍鼧鿳鿸𠈁𠰹𠴻𡐏𡛵𡩆𡹬𢄿𢏜𢑠𢓰𢩷𢩻𢸨𣗸𣟁𣟄𣮆𣶦𣷿𤝛𤣯𤤩𤱡𤱧𤹢𥓿𥞒𥩀𦑑𦚈𦚐𦝦𧔳𧕦𧜲𧣖𧤓𧦭𧧉𧿧𧿶𨁡𨂫𨈷𨉋𨒙𨞌𨹔𨺖𩃰𩃱𩅡𩉺𩎼𩟰𩢊𩢵𩧐𩱾𩿽𪌂𪘕𪘗𫘞𬶍𱐛𱖰𱘲𱶻","wa":"㧚㼘䍪䎳䚴䠚䨟䯉䵷佤劸咓哇嗗嗢娃娲媧屲挖搲攨洼溛漥瓦瓲畖砙穵窊窪聉腽膃蛙袜襪邷韈韤鼃𠴺𠹁𡁌𡚟𡧗𣐎𣢉𣢚𤞇𤬦𤬿𤮰𤿗𥤺𥥟𥿉𦘵𦚩𦞭𦤙𦫪𧧊𨀄𨩶𩨚𩨾𩩤𩿺𱗉","wai":"㖞㗏䠿䴜䶐喎外夞崴歪竵顡𠨃𠰻𢱉𤟷𤤫𤷹𦘍𨂿𨈕𨵞𩔀𩕕𪉭𪑷𱐿𱿯","wan":"㘤㜶㝴㸘㽜㿸䅋䑱䖤䗕䘎䘼䛃䛷䝹䩊䯈䯛䳃万丸倇刓剜卍卐唍埦塆壪妧婉婠完宛岏帵弯彎忨惋抏挽捖捥晚晥晩晼杤梚椀汍湾潫澫灣烷玩琓琬畹皖盌睕瞣碗笂紈綩綰纨绾翫脕脘腕芄菀萖萬薍蜿蟃豌貦贃贎踠輐輓鋄鋔錽鎫頑顽𠒢𠝪𠠪𠣉𡆅𡇿𡤶𡩄𡸥𢀗𢓃𢓆𢛙𢨔𢯲𢺯𣡩𣥃𤗍𤥙𤧩𤻆𥆶𥝄𥟶𥤸𦂔𦙵𦜐𦣾𦲯𦽞𧚇𧠆𧯡𧲦𧹗𧿙𨂺𨈊𨉝𨌔𨞼𨥧𨩯𨩵𨩻𩅦𩈬𩊁𩢄𩣵𩾞𪂦𪂧𪋅𪎛𪐬𪑉𬇕","wang":"㓁㲿㳹㴏䋄䋞䒽䤑䰣亡亾仼兦妄尣尩尪尫彺往徃徍忘惘旺暀望朢枉棢汪瀇王盳網网罒罔莣菵蚟蛧蝄誷輞辋迋魍龬𠕿𡔞𡝝𡯁𡷢𢁶𢛛𢼟𣢫𣥊𣶈𣷪𤷀𥆚𥆜𥲠𥾼𦓋𦖉𦣦𦣩𦬣𦯌𧈿𧎕𧧄𧧜𧫢𨕿𨳠𩖩𩵭𪁘","wei":"㕒㖐㙎㙔㙗㛱㞇㞑㟪㠕㣦㣲㥜㦣㨊㬙㭏㮃㱬㷉䃬䇻䈧䉠䊊䋿䍴䍷䑊䔺䗽䘙䙟䙿䜅䜜䝐䞔䡺䥩䦱䧦䪋䪘䫋䬑䬿䭳䮹䲁䴧䵋䵳为亹伟伪位偉偎偽僞儰卫危厃叞味唯喂喡喴囗围圍圩墛壝委威娓媁媙媦寪尉尾屗峗峞崣嵔嵬嶶巍帏帷幃徫微惟愄愇慰懀捤揋揻撱斖暐未桅梶椲椳楲欈沩洈洧浘涠渨渭湋溈溦潍潙潿濰濻瀢炜為烓煀煒煟煨熭燰爲犚犩猥猬玮琟瑋璏畏痏痿癓硊硙碨磈磑維緭緯縅纬维罻胃腲艉芛苇苿荱菋萎葦葨葳蒍蓶蔚蔿薇薳藯蘶蜲蜼蝛蝟螱衛衞褽覣覹詴諉謂讆讏诿谓踓躗躛軎轊违逶違鄬醀鍏鍡鏏闈闱隇隈霨霺韋韑韙韡韦韪頠颹餧餵饖骩骪骫魏鮇鮠鮪鰃鰄鲔鳂鳚𠄿𠆟𠙕𠥎𠳿𠹤𡂗𡇦𡔱𡚈𡶎𡷕𡼱𢉝𢊯𢍚𢙓𢣘𢯷𢲴𢸦𢼸𢾁𣄺𣈎𣨙𣩪𣫪𣲗𣽴𤀷𤁿𤛲𤜂𤸆𤺉𤻅𤼒𥅵𥉖𥊪𥌰𥒮𥧙𥯜𥯤𥶽𦇅𦈓𦓽𦝛𦠻𦢿𦩝𦩬𦪒𦳢𦾛𧍥𧍫𧐌𧒭𧔥𧕞𧚷𧛚𧝕𧞸𧟼𧢒𧢧𧤖𧲄𧲗𧲝𧳞𧳪𧴖𧸽𨃄𨖿𨗨𨚘𨝀𨟗𨠥𨢉𨪈𨱖𨴓𨵋𨻒𨾂𨿭𩀣𩀶𩁌𩋾𩎵𩏉𩏏𩏿𩑵𩗘𩗜𩜧𩟟𩠯𩤸𩨅𩲂𩲄𩴞𩹂𩹥𩹷𩼂𩼌𩽎𪂄𪑐𪑭𫇭𬀩𬣀𬭬𬱟𬶏𮧵","wen":"㗃㝧㡈㬈㼔䎹䎽䐇䘇䰚刎匁吻呚呡問塭妏彣忟抆揾搵文昷桽榅榲殟汶渂温溫炆玟珳瑥璺瘒瘟稳穏穩紊紋纹聞肳脗芠莬蕰蚉蚊螡蟁豱輼轀辒鎾閺閿闅闦问闻阌雯鞰顐饂馼駇魰鰛鰮鳁鳼鴍鼤𠐢𡁋𢾿𣜺𣶌𤛁𤵒𥁕𥃮𥦊𥧚𥬼𦝮𦟕𦦯𦮶𨆲𨜵𨟸𨶭𨸩𩢌𩥈𩭋𪉃𪉸𫘜","weng":"㘢㜲㮬㹙㺋䈵䐥䩺䱵勜嗡塕奣嵡攚暡滃瓮甕瞈罋翁聬蓊蕹螉鎓鶲鹟齆𠰈𡍻𡩥𡻐𤌏𥕀𦞡𦧅𧚐𧛹𨜺𨞑𩄘𩔚𩡓𩮬𩰎𬭩𮬢𱭰","wo":"㠛㦱㧴㱧㹻䀑䁊䂺䠎䮸䰀仴倭偓卧唩婐媉幄我挝捰捾握撾擭斡枂楃沃涡涴涹渥渦濣焥猧瓁瞃硪窝窩肟腛臒臥莴萵蜗蝸踒雘齷龌𠪧𠷏𠿟𡁮𡎔𡑟𡖲𢦴𢫷𣁳𣂽𣇫𣚝𤆏𤉦𤡓𤻌𥄗𥑣𥟿𥪍𦤨𦯏𦰖𦳹𦷵𧤒𧥋𧶕𨁟𨌝𩈱𩐦𩟓𩭏𩭝𩮑𩷯𪁕𪎤","wong":"𥦷","wu":"㐅㐳㑄㒇㡔㬳㮧㵲㷻㹳㻍㽾䃖䉑䍢䎸䑁䒉䓊䖚䛩䜑䟼䡧䦍䦜䨁䫓䮏䳇䳱乄乌五仵伆伍侮俉倵儛兀剭务務勿午卼吳吴吾呉呒呜唔啎嗚圬坞塢奦妩娪娬婺嫵寤屋屼岉嵍嵨巫庑廡弙忢忤怃悞悟悮憮戊扤捂摀敄无旿晤杇杌梧橆歍武毋汙汚污洖洿浯溩潕烏焐無熃熓物牾玝珷珸瑦璑甒痦矹碔祦禑窏窹箼粅舞芜芴茣莁蕪蘁蜈螐蟱誈誣誤譕诬误躌迕逜邬郚鄔鋈錻鎢钨铻阢隖雺雾霚霧靰騖骛鯃鰞鴮鵐鵡鶩鷡鹀鹉鹜鼯鼿齀𠒄𠘻𠛆𠞆𠥢𠯃𠵦𠼘𡈎𡈞𡬫𡯇𡵉𡷤𢁢𢃀𢄓𢋹𢑟𢓲𢗳𢙁𢜮𢝴𢨂𢩈𢫸𣟒𣨓𣬽𣯎𣲘𣺀𤆡𤣬𤭑𤵐𤸼𥁡𥎈𥎮𥏒𥒀𥕻𥟽𥭠𥲐𥾕𦆞𦌬𦎦𦥁𦨉𦨳𦬂𦶀𦷽𦼇𧆹𧈭𧎻𧐙𧑕𧨆𧰈𧳎𧴇𧺴𧽋𨂣𨑥𨖴𨡡𨧗𨨡𨲬𨶇𨼊𨿏𩄯𩒾𩓦𩗽𩝕𩝷𩠟𩳌𩵱𩶭𩻚𪄝𪑱𱖫𱣂𱩉𱳳","xi":"㑶㓾㔒㕃㕧㗩㗭㘊㙾㚀㚛㛓㛫㛭㜎㜯㠄㣟㤸㦦㦻㩗㪧㬛㭡㮩㯕㰥㰿㱆㱤㲸㴧㶉㸍㺣㽯㾷㿇㿽䀌䁯䂀䈪䊠䏩䏮䐅䐖䐼䒁䒊䓇䖒䖷䙵䚫䛊䛥䜁䢄䧍䨳䫣䬣䭒䮎䲪䳶䵱䶋习係俙傒僖兮凞匸卌卥厀吸呬咥唏唽喜喺嘻噏嚱囍墍壐夕奚媳嬆嬉屃屖屣屭嵠嶍嶲巇希席徆徙徯忚忥怬怸恄恓息悉悕惁惜慀憘憙戏戱戲扱扸昔晞晰晳暿曦析枲桸椞椺榽槢樨橀橲檄欯欷歖氥汐洗浠淅渓溪滊漇漝潝潟澙烯焁焈焟焬煕熂熄熈熙熹熺熻燨爔牺犀犔犠犧狶玺琋璽瘜皙盻睎瞦矖矽硒磎磶礂禊禧稀稧穸窸粞糦系細綌緆縘縰繥繫细绤羲習翕翖肸肹膝舃舄舾莃菥葈葸蒠蒵蓆蓰蕮薂虩蜥螅螇蟋蟢蠵衋袭襲西覀覡覤觋觹觽觿諰謑謵譆谿豀豨豯貕赥赩趇趘蹝躧邜郋郗郤鄎酅醯釳釸鈢鉨鉩錫鎴鏭鑴铣锡闟阋隙隟隰隵雟霫霼飁餏餼饩饻騱騽驨鬩鯑鰼鱚鳛鵗鸂黖鼷𠅤𠆱𠉢𠔃𠔍𠘕𠜗𠟊𠤴𠦌𠦜𠨚𠩺𠪙𠬬𠴭𠶨𠺒𡁱𡃢𡅕𡏛𡗞𡗳𡘐𡘡𡙋𡜧𡝧𡦎𡩤𡳚𡶯𡻎𢀊𢊚𢋼𢑧𢒩𢒲𢗴𢙅𢜣𢡁𢤋𢧽𢨟𢬾𢭁𢹍𣅾𣎮𣒃𣚔𣟵𣢁𣢂𣢍𣢎𣢑𣢓𣣉𣤢𣤳𣤴𣨗𣯪𣳦𣳬𤃪𤄎𤄬𤌷𤓔𤓚𤟧𤠓𤡡𤡬𤢀𤤱𤥒𤨐𤬕𤬘𤮆𤮙𤲺𤳥𤶈𤶰𤷡𤹊𤺊𥄖𥄛𥈜𥈻𥋁𥋟𥎃𥪦𥮬𥰝𥰥𥺚𥻥𥿭𦃝𦐠𦙝𦜱𦞝𦞽𦠪𦤈𦩭𦪿𦮐𦱓𦷲𦸚𦼗𧀬𧂙𧈅𧈍𧈼𧉁𧋐𧎵𧐔𧚃𧣩𧤟𧥤𧦁𧧹𧪢𧬈𧬊𧯈𧯊𧯗𧱲𧲘𧶖𧹨𧹶𧹽𧺨𧻶𧿅𧿝𨀙𨋦𨐛𨛳𨜐𨞘𨡂𨭎𨮪𨰿𨳛𨵎𨷘𨻁𨻥𩅖𩊿𩍆𩎉𩎥𩒽𩗊𩗱𩛹𩦇𩭡𩲁𩽨𩾼𩿛𪃼𪄛𪄶𪅲𪓷𪕯𪖥𫍰𫍻𫘬𬭳𬶮𱱚𱸃𲆦𲆰𲇊","xia":"㔠㗇㘡㙈㙤㰨㰰㰺㽠䖎䖖䘥䛅䠍䪗䫗丅下乤侠俠傄匣吓嚇圷夏夓峡峽懗敮暇柙梺炠烚煆狎狭狹珨瑕疜疨睱瞎硖硤碬磍祫筪縀縖罅翈舝舺蕸虲虾蝦谺赮轄辖遐鍜鎋鎼鏬閕閜陜陿霞颬騢魻鰕鶷黠𠢆𠩘𠽫𡈮𡏘𡨄𡺷𢈙𢈤𢑓𢗄𢘉𢚌𢝅𢩹𢻗𣢗𣹱𤗭𤙇𤟝𤪆𤪍𥁆𥯾𥰶𥻴𦦕𦵯𦾏𧆥𧇍𧈄𧔂𧕱𧦎𧪕𧪹𧫒𧯋𨩽𨲑𨳉𨻲𨽯𩄗𩉾𩎲𩏓𩐀𩝛𩮂𪄂𪗾𪘘𫚥𬯅𲂆","xian":"㔾㘅㘋㛾㡉㡾㢺㦑㦓㧥㪇㫫㬎㬗㭠㭹㮭㯗㰊㰹㲔㳄㳭㵪㶍㷿㸝㺌㺤㽉㾾㿅㿌䁂䂅䃱䃸䄳䆎䉯䉳䊱䏹䐄䕔䗾䘆䙹䚚䜢䝨䢾䤼䥪䦘䦥䧋䧟䧮䨘䨷䩂䯭䯹䱤䲗䵇䵌䶟仙仚伭佡僊僩僲僴先冼县咞咸哯唌啣嘕垷壏奾妶姭娊娨娴娹婱嫌嫺嫻嬐宪尟尠屳岘峴崄嶮幰廯弦忺憪憲憸挦掀搟撊撏攇攕显晛暹杴枮橌櫶毨氙涀涎湺澖瀗灦烍燹狝猃献獫獮獻玁现珗現甉痫癇癎県睍瞯硍礥祆禒秈稴筅箲籼粯糮絃絤綫線縣繊纎纖纤线缐羡羨胘腺臔臽舷苋苮莧莶薟藓藖蘚蚬蚿蛝蜆衔衘褼襳誢誸諴譣豏賢贒贤赻跣跹蹮躚輱酰醎銑銛銜鋧錎鍁鍌鑦铦锨閑閒闲限陥险陷険險霰韅韯韱顕顯餡馅馦鮮鱻鲜鶱鷳鷴鷼鹇鹹麙麲鼸𠏓𠏡𠓌𠚆𠛑𠜎𠠁𠫄𠯟𠷢𠿢𡐖𡒓𡗏𡞣𡫹𡰲𡸃𡾮𡿤𢁗𢅮𢋮𢎙𢐐𢒆𢕖𢕭𢖋𢖎𢖝𢚀𢛆𢥌𢫿𢮂𢷑𢹚𣆕𣊺𣑹𣔙𣕎𣞘𣟲𣭡𣮾𣰷𤁦𤈷𤉌𤑃𤓤𤞤𤟅𤼂𥑻𥓒𥙆𥜲𥟕𥦶𥬍𥰳𥲋𥻇𥻧𥽏𦋈𦎵𦑘𦒜𦠹𦧐𦩢𦭶𦱁𦸊𦽭𧂞𧈁𧕇𧖙𧠒𧫹𧱀𧸂𧻒𧼏𧾨𨁅𨇤𨍒𨏥𨐊𨖱𨘙𨘞𨙡𨚾𨵬𨸄𨺘𩈖𩏩𩝈𩤊𩤥𩤦𩦂𩦹𩧩𩨡𩱆𩶤𪂶𪄏𪄷𪎉𪔩𪾢𫍯𬀪𬭣𬸣𮬣𲍏","xiang":"㐮㗽㟄㟟䊑䐟䔗䖮䜶䢽䦳䬕䴂乡享亯佭像勨厢向响啌嚮塂姠嶑巷庠廂忀想晑曏栙楿橡欀湘珦瓖瓨相祥稥箱絴緗缃缿翔膷芗萫葙薌蚃蟓蠁衖襄襐詳详象跭郷鄉鄊鄕銄銗鐌鑲镶響項项飨餉饗饟饷香驤骧鮝鯗鱌鱜鱶鲞麘𠸮𡹷𢄵𢛖𢞡𢠷𢪷𢭎𣂝𣅰𣨳𤉪𤍀𤖽𤝷𤩪𤭬𤰅𤷼𥀾𥊾𥗵𥣟𥫖𥿧𦍲𦍴𦎈𦕺𦺣𦺨𧖿𧬰𨀘𨉽𨖶𨙵𨛜𨧑𨷄𨷿𩑇𩝾𩞥𩡌𩡠𩾬𪂼𬁠𬙋","xiao":"㔅㕺㗛㚠㚣㤊㩋㪣㬵㮁㲖㵿㹲㺒䉰䊥䌃䎄䒕䒝䕧䟁䥵䨭䬘䴛侾俲傚効呺咲哓哮啸嘋嘐嘨嘯嘵嚣嚻囂婋孝宯宵小崤庨彇恷憢揱效敩斅斆晓暁曉枭枵校梟櫹歊歗殽毊洨消涍淆潇瀟灱灲焇熽猇獢痚痟皛皢硝硣穘窙笑筊筱筿箫篠簘簫綃绡翛肖膮萧萷蕭藃虈虓蟂蟏蟰蠨訤詨誟誵謏踃逍郩銷销霄驍骁髇髐魈鴞鴵鷍鸮𠈬𠏕𠑪𠴡𠴳𠹎𡟣𡣾𡥍𡦝𡦳𡧕𡯩𡱉𡷸𡼚𢓮𢛘𢪶𢭦𢸳𢹳𢽾𣂬𣏠𣔷𣕇𣟇𣠎𣤡𣱓𣿣𤑳𤕢𤞚𤟞𤠖𤡔𤣌𤣠𤷤𤺃𤽳𤿨𥆔𥔑𥕾𥽁𥾤𦏷𦐺𦟞𦢩𦦛𦯪𦱜𦺔𧄤𧍂𧡼𧢬𧩮𧱐𧳍𧵱𨅋𨊅𨠦𨴹𨶅𩋍𩙚𩙮𩧓𩫂𩫳𩱴𩵖𩾒𩾓𩾾𪁎𪊷𪛀𪮋𫍲","xie":"㐖㒠㓔㔎㕐㖑㖿㗨㙝㙦㙰㝍㞒㞕㡜㢵㣯㣰㥟㦪㨙㨝㩦㩪㭨㰔㰡㱔㳦㳿㴬㴮㴽㸉㽊㾚䀘䁋䉏䉣䊝䔑䕈䕵䙊䙎䙝䙽䚸䝱䡡䥱䥾䦏䦖䩤䩧䪥䲒䵦些亵伳偕偞偰僁写冩劦勰协協卨卸嗋噧垥塮夑奊娎媟寫屑屓屟屧峫嶰廨徢恊愶懈拹挟挾揳携撷擕擷攜斜旪暬械楔榍榭歇泄泻洩渫澥瀉瀣灺炧炨烲焎熁燮燲爕猲獬瑎祄禼糏紲絏絬綊緤緳繲纈绁缬缷翓胁脅脇脋膎薢薤藛蝎蝢蟹蠍蠏衺褉褻襭諧謝讗谐谢躞邂邪鞋鞢鞵韰頡齂齘齛齥龤𠅱𠑄𠖹𠗉𠨆𠲊𠸴𠿇𡀺𡃂𡄕𡗼𡛶𡞘𡟩𡣹𡤋𡭥𡰢𡸔𡽖𢂐𢌀𢓬𢖆𢗊𢜨𢞜𢤯𢤰𢥘𢬿𢯉𢴲𢹒𢿡𣆟𣒄𣞐𣣩𣣲𣣶𣫴𣬕𣹩𣻠𣽒𤑪𤗈𤙒𤞡𤡧𤢺𤣑𤫉𤮯𤱷𤺎𥀺𥆥𥇱𥊯𥌨𥍆𥎎𥗧𥢹𦁛𦋅𦔼𦖐𦚡𦚫𦞚𦩌𦪬𦳃𦵱𧀢𧀺𧌊𧌋𧌖𧍁𧏂𧏃𧐃𧑦𧓂𧓺𧖁𧛼𧜔𧜵𧝫𧟃𧭠𧭸𧳧𧷑𧷧𨁂𨇨𨈙𨏳𨤴𨧥𨳚𨵚𨵪𨷥𨼬𩂪𩃖𩋘𩋧𩍝𩎃𩐁𩐉𩙜𩤠𩫲𩰳𩷂𩺫𩽍𪆋𪙥𫧯𬹼𮖱𱜒𱾎𲀯","xin":"㐰㔤㚯㛙㛛㜦㣺㭄㭢㾙䅽䒖䚱䛨䜗䜣伈伩信俽噺囟妡嬜孞廞心忄忻惞新昕杺枔欣歆炘焮盺脪舋芯薪衅襑訢訫軐辛邤釁鈊鋅鐔鑫锌阠顖馨馫馸𠑰𠷓𡈏𡌜𢋆𢗀𢠝𢩲𢭧𣂗𣂜𣃄𣥇𤙖𤙣𤜢𤣲𤫨𤴾𤷓𤹩𦁍𦉝𦜓𦞤𦢯𦤟𦰸𧗹𧳄𧴢𨊳𨓇𩖣𩟍𩾽𩿃𫷷𬒘","xing":"㐩㓑㓝㙚㝭㣜㨘㷣㼛㼬䁄䂔䃏䓷䕟䗌䛭䣆䤯䰢䳙侀倖兴刑哘型垶姓娙婞嬹幸形性悻惺擤星曐杏洐涬滎煋猩瑆皨睲硎箵篂緈腥臖興荇荥莕蛵行裄觪觲謃邢郉醒鈃鉶銒鋞钘铏陉陘騂骍鮏鯹鿿𠀦𠬋𡃳𡶭𢙼𢜫𣢝𣨾𣸝𤏽𤙡𤬐𤶲𥠀𥨕𦂅𦈒𦈨𦈵𦖤𦩠𧊞𧊽𧌚𧗦𧛟𨌍𨞾𩈡𩩋𫰛𲋁","xiong":"㐫㚾䧺兄兇凶匂匈哅夐忷恟敻汹洶焸焽熊胷胸訩詗詾讻诇賯雄𠓙𡨳𡪰𡬁𢢹𢿌𣅷𤔫𤛪𥃴𥥧𥦥𦈤𦓈𦙄𦬺𦵡𧘮𧞞𧰯𧵣𧽒𧿖𨥍𩌠𩧊𩴂","xiu":"㗜㱗㱙㳜㵻㹋㾋䏫䐰䗛䡭休俢修咻嗅岫峀庥朽樇溴滫潃烋烌珛琇璓秀糔綇繍繡绣羞脙脩臹苬螑袖褎褏貅銝銹鎀鏅鏥鏽锈飍饈馐髤髹鮴鱃鵂鸺齅𡔨𡜨𡟞𡯐𢊒𢓵𢕦𣧬𤚯𥌪𥞼𦈋𦟤𦪋𧌌𧙏𩘭𩛢𩡎𩢮𩭘𩮄𪀪𪁮𪕦𪘆𲇙","xu":"㐨㑔㑯㕛㖅㗵㘧㜅㜿㞊㞰㥠㰭㳚㵰㷦㺷㽳䂆䅡䇓䈝䋶䍱䎉䏏䔓䘏䙒䛙䢕䣱䣴䦗䦽䧁䬄䱬䳳伵侐俆偦冔勖勗卹叙吁呴喣嘘噓垿墟壻姁婿媭嬃幁序徐怴恤慉戌揟敍敘旭旴昫晇暊朂栩楈槒欨欰歔殈汿沀洫湑溆漵潊烅烼煦獝珝珬疞盢盨盱瞁瞲稰稸窢糈絮続緒緖縃繻續绪续聓聟胥芧蒣蓄蓿蕦藇藚虗虚虛蝑裇訏許訹詡諝譃许诩谞賉鄦酗醑銊鑐需須頊须顼驉鬚魆魖魣鱮𠆐𠜄𠧰𠷙𠹘𠾫𡦁𡱣𡹲𢄼𢖳𢨁𢨰𢩕𣅤𣆒𣊞𣚏𣢊𣨤𣰃𣸃𤆞𤇳𤚉𤟠𤡣𤡶𤬱𤭽𤲸𤷇𤸀𥄵𥅺𥆛𥇏𥇿𥈈𥊊𥍟𥎕𥎗𥕰𥚩𥮪𥳗𦄼𦅏𦈡𦑍𦕓𦘼𦜃𦝳𦠷𦪡𦯅𦰰𦰲𦲰𧁃𧆜𧆡𧊥𧏺𧕼𧙆𧟬𧧓𧪮𧶍𧹭𧹴𧼑𨂠𨅑𨋾𨌎𨍐𨜿𨞣𨣦𨬗𨴎𨵮𨷔𨹘𨻍𨼋𩂉𩌮𩌲𩍳𩑕𩒇𩒧𩓣𩔴𩔼𩖕𩝔𩠋𩣊𩪉𩰠𩽆𩾊𪆛𪖩𪙫𪾔𫚈𬣙𱙧𲀄","xuan":"㓩㔯㔵㘣㝁㦥㧋㧦㩊㯀㳙㳬㹡㻹㾌䀏䁔䁢䃠䆭䍗䍻䗠䚙䚭䝮䠣䧎䩙䩰䮄䲂䲻䳦儇吅咺喧塇媗嫙宣弲怰悬愃愋懁懸揎旋昍昡晅暄暶梋楥楦檈泫渲漩炫烜煊玄玹琁琄瑄璇璿痃癣癬眩眴睻矎碹禤箮絢縇縼繏绚翧翾萱萲蓒蔙蕿藼蘐蜁蝖蠉衒袨諠諼譞讂谖贙軒轩选選鉉鋗鍹鏇铉镟鞙顈颴駽鰚𠗻𠣖𠥞𠵷𡈣𡈴𡬳𡾥𢂄𢈋𢏧𢙂𢰊𢳄𣉖𣎓𣟳𤂿𤟿𥌭𥥾𥶷𦈝𦌔𦐽𦑙𦛔𧉎𧐗𧑩𧔤𧜽𧟨𧡚𧡢𧤎𧾆𧾎𨁁𨊼𨹆𩃚𩉥𩋢𩋫𩋱𩑹𩕖𩕪𩘒𩙢𩤡𪍧𫍽𫓶𫠊","xue":"㕰㖸㗾㞽㰒㶅㻡㿱䆝䆷䋉䎀䒸䛎䤕䦑䨮䫼䬂䭥䱑乴削吷坹壆学學岤峃嶨斈桖樰泶澩瀥燢狘疶穴膤艝茓蒆薛血袕觷謔谑趐踅轌辥辪雤雪靴鞾鱈鳕鷽鸴𢪎𢯳𢼺𣧌𣧡𣧵𣪨𣺭𤀰𥀣𥄎𥄒𥄴𥅧𦐍𦥯𦰾𧉢𧔗𧮞𧸗𨑣𨭁𩌊𩖱𩖶𪃅𰃮","xun":"㖊㜄㡄㢲㨚㰬㵌㽦䋸䖲䗼䘩䙉䛜䞊䠝䭀䵫伨侚偱勋勛勲勳卂噀噚嚑坃埙塤壎壦奞寻尋峋巡巺巽廵徇循恂愻揗攳旬曛杊栒桪樳殉殾毥汛洵浔潠潯灥焄熏燅燖燻爋狥獯珣璕畃矄稄窨紃纁臐荀荨蔒蕈薫薰蘍蟳訊訓訙詢训讯询賐迅迿逊遜鄩醺鑂顨馴駨驯鱏鱘鲟𠊫𠹀𡑎𡺕𡿼𢏤𣌨𣖼𣹯𤃺𤑕𤛧𤿟𥒘𥙣𥳍𥾡𦅀𦅑𦘶𦠅𦫯𦳣𧥿𧰣𧸩𧾝𧾠𧾩𨀴𨺮𨼔𩊻𩖰𩠇𩪱𩷰𩾄𩾧𪀠𪀽𪇑𫄸𬊈𬍤𬘓𬩽𱪿𲆫","ya":"㝞㧎㰳㳌㾎㿿䃁䄰䅉䆘䝟䢝䦪䪵䰲丫乛亚亜亞伢俹劜厊压厑厓吖呀哑唖啞圔圠圧垭埡堐壓娅婭孲岈崕崖庌庘押挜掗揠枒桠椏氩氬涯漄牙犽猚猰玡琊瑘痖瘂睚砑稏窫笌聐芽蕥蚜衙襾訝讶軋轧迓錏鐚铔雅鴉鴨鵶鸦鸭齖齾𠄮𠋗𠜲𠮜𠵣𡇼𡴭𡶦𡷻𡸗𡹄𢛄𢛟𢮊𣉩𣏎𤘅𤘆𤴓𤵭𤹎𥇠𥏝𥐕𥒧𦉟𦉧𦜖𧈝𧓪𧧝𧬬𨁶𨓴𨖭𨨙𨸺𩃐𩨠𩭯𩮝𩿔𪆰𪗹𪘲𱥰","yan":"㕣㖶㗴㘖㘙㚧㛪㝚㢂㢛㤿㦔㫃㫟㬫㭺㮒㰽㳂㶄㷔㷳㷼㸶㺂㿕㿼䀋䀽䁙䂩䂴䄋䅧䇾䉷䊙䌪䍾䎦䑍䓂䖗䗎䗡䗺䛳䜩䞁䞛䢥䢭䣍䤷䦲䨄䫡䲓䳛䳡䳺䴏䶫䶮严乵俨偃偐偣傿儼兖兗剦匽厌厣厭厳厴咽唁啱喭噞嚥嚴堰塩墕壛壧夵奄妍妟姲姸娫娮嫣嬊嬮嬿孍宴岩崦嵃嵒嵓嶖巌巖巗巘巚延弇彥彦恹愝懕懨戭扊抁掩揅揜敥昖晏暥曕曣曮棪椻椼楌樮檐檿櫩欕沇沿淊淹渰渷湮溎滟演漹灎灔灧灩炎烟烻焉焑焔焰焱煙熖燄燕爓牪狿猒珚琂琰甗盐眼研砚硏硯硽碞礹筵篶簷綖縯罨胭腌臙艳艶艷芫莚菸萒葕蔅虤蜒蝘衍裺褗覎觃觾言訁訮詽諺讌讞讠谚谳豓豔贋贗赝躽軅遃郔郾鄢酀酓酽醃醶醼釅閆閹閻闫阉阎隁隒雁顏顔顩颜餍饜騐験騴驗驠验鬳魇魘鰋鳫鴈鴳鶠鷃鷰鹽麣黡黤黫黬黭黶鼴鼹齞齴龑𠆲𠍛𠘥𠛭𠝢𠰖𠻤𡙶𡚇𡣽𡹶𢅠𢇈𢇘𢈂𢉘𢌨𢔂𢜰𢤍𢯼𢸴𢾑𣃧𣃳𣃾𣄉𣄑𣄝𣝎𣡕𣡞𣡶𣥡𣩙𣭻𣼞𣼠𤂠𤅊𤅸𤎄𤖝𤗎𤜵𤟇𤟟𤡖𤡥𤢋𤫣𤬝𤯇𤯐𤲩𤸹𥀬𥂁𥃿𥍻𥕼𥜒𥣘𥤟𥤴𥯃𥴿𥶿𥷀𦁏𦁙𦌚𦎣𦏥𦏹𦑎𦖈𦖧𦛞𦛣𦝪𦧡𦫤𧇱𧊔𧍢𧎘𧞣𧠦𧥜𧩅𧬌𧴣𧹬𧺅𧻃𧽉𧽞𧾤𨀅𨁍𨁹𨂪𨃰𨒄𨟹𨠭𨡄𨡎𨡣𨣻𨤎𨪶𨴣𨶁𨷽𨸮𨺥𨻂𨻳𨽑𩃀𩈯𩒖𩗷𩜽𩣲𩩄𩩴𩩶𩪴𩳢𩸞𩻖𪁡𪂈𪑈𪒝𪒠𪗙𪗤𪙊𪩘𫄧𬙂𬸘𮭨𰟘𱱭","yang":"㒕㔦㟅㦹㨾㬕㺊㿮䁑䄃䍩䑆䒋䖹䬗䬺䭐䱀䵮仰佒佯傟养劷咉坱垟央姎岟崵崸徉怏恙慃懩扬抰揚攁敭旸昜暘杨柍样楊楧様樣殃氜氧氱泱洋漾瀁炀炴烊煬珜疡痒瘍癢眏眻礢禓秧紻羊羏羕羪胦蛘蝆詇諹軮輰鉠鍚鐊钖阦阳陽雵霷鞅颺飏養駚鰑鴦鴹鸉鸯𠃓𠍵𠢴𠮴𡠘𡡂𡩶𡹕𢏙𢟣𢵇𢽕𣃝𣉚𣐫𣗹𤞢𤢐𤢮𤸡𥂸𥃽𥒞𥠜𥥵𥬴𥳜𦍕𦍹𦏱𦭵𦯒𦴊𦼴𧓲𧥴𧫛𧲱𧵌𨋕𨋽𨎔𨒫𨖌𨱝𩊑𩋬𩤟𩧫𩲴𩴨𪓛𪕫𪚻𱸖","yao":"㑸㑾㔽㙘㝔㞁㟱㢓㨱㫏㫐㴭㵸㹓㿑㿢䁏䁘䂚䆗䆙䆞䋂䌁䌊䌛䔄䖴䙅䚺䚻䛂䠛䢣䬙䯚䳩䴠䶧䶸仸倄偠傜吆咬喓嗂垚堯夭妖姚婹媱宎尧尭岆峣崾嶢嶤幺徭愮抭揺搖摇摿暚曜杳枖柼楆榚榣殀溔滧烑熎燿爻狕猺獟珧瑤瑶眑矅磘祅穾窅窈窑窔窯窰筄繇纅耀肴腰舀艞苭药葯葽蓔薬藥蘨袎要覞訞詏謠謡讑谣軺轺遙遥邀邎銚鎐鑰钥闄靿顤颻飖餆餚騕鰩鳐鴁鴢鷂鷕鹞鼼齩𠌠𠍩𠏈𠑐𠕻𠟋𠢩𠣑𠹑𡆩𡔜𡛙𡝛𡝩𡢹𡣠𡨇𡩸𡶂𡺯𢂊𢅹𢆷𢆽𢈆𢊙𢋇𢑈𢝍𢺇𣣳𣨘𤂼𤄶𤒝𤚭𤫺𤬔𤬖𤾫𥁒𥃺𥌺𥤣𥤹𥦖𥪯𥬓𥹱𦆸𦇬𦔷𦡱𦤋𦥝𦦌𦾺𦾾𧄎𧇠𧍔𧠽𧢢𧤮𧷋𧽎𨍳𨓳𨘔𨱧𨹋𩋃𩑗𩑴𩜸𩢒𩥣𩨴𩩼𩬗𩯛𩲻𩳔𩿕𪐯𪖐","ye":"㖡㗼㙒㡋㥷㩎㪑㱉㱌㸣䁆䈎䊦䎨䓉䢡䤳䤶䥟䥡䥺䧨䭇䭎䭟䱒䲜业也亪亱倻僷冶叶吔啘嘢噎嚈埜堨墷壄夜嶪嶫抴捓捙掖揶擛擨擪擫晔暍曄曅曗曳曵枼枽椰楪業歋殗洂液漜潱澲烨燁爗爷爺璍皣瞱瞸礏耶腋葉蠮謁谒邺鄓鄴野釾鋣鍱鎁鎑鐷铘靥靨頁页餣饁馌驜鵺鸈𠀸𠄅𠟪𠥇𠱝𡀽𡁁𡑀𡛌𡛽𡽣𢀘𢉥𢢜𢪧𢱴𣎩𣐂𣚋𣚕𣩫𣩯𣰛𤑷𤝇𤝉𤝱𤳪𥌅𥠍𥮧𥯘𦀕𦂡𦕆𦠜𦤪𦰳𧎭𧏽𧐓𧒐𧔦𧗖𨂒𨈺𨉅𨶮𨸌𨼥𨽀𩉂𩐱𩑃𩘏𩜺𩱝𩸾𩼋𩼴𪋫𪍅𪑦𪒲𱛹𲈍𲍿","yi":"㐌㐹㑊㑜㑥㓷㔴㕈㖂㘁㘈㙠㙪㙯㚤㚦㛄㛕㛳㜋㜒㝖㝣㞔㠖㠯㡫㡼㢞㣇㣻㥋㥴㦉㦤㦾㫊㰘㰝㰻㱅㱞㱲㲼㳑㳖㴁㴒㴔㵝㵩㶠㹫㹭㺿㼢㽈㾨䃜䄁䄩䄬䄿䆿䇩䇵䇼䉗䉝䉨䋚䋵䌻䎈䒾䓃䓈䓹䔟䔬䔱䕍䖁䖊䖌䗑䗟䗷䘝䘸䚷䝘䝝䝯䞅䢃䣡䣧䦴䧅䧇䧧䩟䪰䫑䬁䬥䬮䭂䭞䭲䭿䮊䯆䰙䰯䱌䲑䴊䴬䵝一乁乂义乊乙亄亦亿以仪伇伊伿佁佚佾侇依俋倚偯儀億兿冝刈劓劮勚勩匇匜医吚呓呭呹咦咿唈噫囈圛圯坄垼埶埸墿壱壹夁夷奕姨媐嫕嫛嬄嬑嬟宐宜宧寱寲屹峄峓崺嶧嶬嶷已巸帟帠幆庡廙异弈弋弌弬彛彜彝彞役忆怈怡怿恞悒悘悥意憶懌懿扅扆抑拸挹掜揖撎攺敡敼斁旑旖易晹暆曀曎杙枍枻柂栘栧栺桋棭椅椬椸榏槸檍檥檹欥欭欹歝殔殪殹毅毉沂沶泆洢浂浥浳渏湙溢漪潩澺瀷炈焲熠熤熪熼燚燡燱狋猗獈玴珆瑿瓵畩異疑疫痍痬瘗瘞瘱癔益眙睪瞖矣硛礒祎禕秇移稦穓竩笖箷簃籎縊繄繶繹绎缢羛羠義羿翊翌翳翼耛耴肄肊胰膉臆舣艗艤艺芅苅苡苢萓萟蓺薏藙藝蘙虉蚁蛜蛡蛦蜴螔螘螠蟻衣衤衪衵袘袣裔裛裿褹襼觺訑訲訳詍詑詒詣誃誼謻譩譯議讉讛议译诒诣谊豙豛豷貖貤貽賹贀贻跇跠踦軼輢轙轶辷迆迤迻逘逸遗遺邑郼酏醫醳醷釔釴鈘鈠鉯銥鎰鏔鐿钇铱镒镱陭隿霬靾頉頤頥顊顗颐飴饐饴駅驛驿骮鮨鯣鳦鶂鶃鶍鷁鷊鷖鷧鷾鸃鹝鹢鹥黓黟黳齮齸𠂆𠄱𠅌𠈶𠍫𠍳𠏩𠐀𠓋𠗺𠚮𠛃𠜁𠡔𠡝𠤕𠤗𠤘𠥦𠨾𠩗𠩫𠪗𠬤𠮙𠯋𠰄𠲔𠲖𠲚𠲺𠲻𠶷𠼪𠽜𠿣𡄵𡄻𡉛𡊁𡊶𡍡𡜬𡥁𡬐𡬓𡱐𡷪𡻣𡼎𡾾𢀁𢂒𢂗𢂼𢄅𢇙𢇚𢇸𢈶𢊘𢍰𢎀𢎃𢎉𢏗𢓀𢓡𢕷𢖅𢖫𢖴𢖺𢗎𢘽𢙇𢞉𢡃𢣉𢦕𢨮𢨳𢩮𢩼𢱁𢷔𣎅𣐓𣐵𣐿𣕁𣘦𣙛𣚘𣡊𣢭𣢷𣤪𣦌𣧄𣨟𣫙𣶫𣷩𣸘𣿉𤆾𤇴𤈙𤑹𤖪𤘊𤝳𤝻𤣨𤣮𤤺𤥿𤧕𤬩𤴧𤶛𤷅𤸸𤻂𤼌𥃠𥃸𥄻𥄿𥅓𥌟𥍴𥏜𥑴𥒵𥘒𥘠𥙁𥙇𥜃𥜥𥟘𥡪𥥌𥥴𥩖𥫃𥫜𥫝𥰧𥱃𥸊𥹋𥾐𥿹𦌩𦎝𦏸𦓻𦔜𦔥𦘳𦙨𦚟𦟧𦠉𦡫𦥱𦨇𦭥𦮸𦶂𧃟𧅖𧆦𧈻𧉅𧊣𧊤𧋏𧑌𧓗𧔮𧙡𧜤𧡇𧢂𧣟𧣬𧦧𧫦𧬇𧮒𧱊𧱏𧳁𧷅𧷥𧺎𧺝𧾰𨋯𨛯𨜶𨜽𨠑𨠶𨣠𨣬𨦯𨱁𨳷𨹝𨻊𨻏𨽹𩂒𩂹𩈭𩋌𩍖𩎭𩎷𩓧𩔦𩕲𩖹𩖾𩗑𩘧𩚂𩚇𩛆𩛮𩟉𩠂𩡣𩣞𩤒𩥯𩧭𩪟𩪣𩮵𩳇𩴜𩴮𩷍𩷘𩸨𩼨𩾘𩾠𩾢𪀓𪀕𪁚𪁛𪈨𪎈𪐔𪐘𪐣𪒕𪕶𪗷𪘃𪘬𪙴𫄷𫍙𫍟𫖮𬟁𬬩𬺈𮬜𰷠𱊈𲍇","yin":"㐆㐺㒚㕂㖗㙬㝙㞤㡥㣧㥯㥼㦩㧈㧢㪦㱃㴈㶏㸒㹜㹞䄄䇙䌥䒡䓄䓰䕃䕾䖐䖜䚿䜾䡛䤃䨸䪩䲟䴦乑乚侌冘凐印吟吲喑噖噾嚚囙因圁垔垠垽堙堷夤姻婣婬寅尹峾崟崯嶾廕廴引愔慇慭憖憗懚斦朄栶檃檭檼櫽歅殥殷氤泿洇洕淫淾湚溵滛濥濦烎犾狺猌珢璌瘖瘾癊癮碒磤禋秵筃粌絪緸胤苂茚茵荫荶蒑蔩蔭蘟蚓螾蟫裀訔訚訡誾諲讔赺趛輑鄞酳鈏鈝銀銦铟银闉阥阴陰陻隂隐隠隱霒霠霪靷鞇音韾飮飲饮駰骃鮣鷣齗龂𠃊𠖟𠪚𠽨𡇂𡈲𡋪𡐔𡓓𡓿𡖣𡩘𡸛𡼽𢂨𢉩𢋻𢌲𢓕𢓙𢛦𢝯𢪪𢳃𢷍𣓆𣔸𣘴𣦫𣱐𣱜𣸊𣽮𤂹𤝎𤢦𤯸𤵯𤷏𤻘𥖵𥤷𥬜𥮍𦈑𦈠𦜲𦝴𦟘𦾻𧊭𧥸𧦸𧦹𧩬𨈧𨋙𨏈𨐐𨒦𨓮𨛊𨟏𨟴𨡢𨢂𨦆𩂢𩂥𩃬𩐞𩖄𩚕𩬵𪔰𪔽𪘎𪙤𪙾𪛊𫡑𬄩𬘡𬤇𬮱𮙊","ying":"㑞㡕㢍㨕㲟㵬㶈㹚㹵㼆㿘䀴䁐䁝䃷䊔䑉䓨䕦䙬䚆䣐䤝䤰䦫䧹䨍䪯䬬䭊䭗䭘䴍䵴偀僌啨営嘤噟嚶塋婴媖媵嫈嬰嬴孆孾巊应廮影応愥應摬撄攍攖映暎朠桜梬楹樱櫻櫿浧渶溁溋滢潁潆濙濚濴瀅瀛瀠瀯瀴灐灜煐熒營珱瑛瑩璎瓔甇甖瘿癭盁盈矨硬碤礯穎籝籯緓縈纓绬缨罂罃罌膡膺英茔荧莹莺萤营萦萾蓥藀蘡蛍蝇蝧蝿螢蠅蠳褮覮謍譍譻賏贏赢軈迎郢鍈鎣鐛鑍锳霙鞕韺頴颍颕颖鱦鴬鶑鶧鶯鷪鷹鸎鸚鹦鹰𠊶𠝟𠠜𠮳𠸄𡀘𡁊𡂚𡃅𡄖𡎘𡺡𢄋𢣙𢥏𣋋𣟤𣤵𣲜𤁽𤇾𤌌𤜉𤟣𤣎𤭫𤹜𤹥𥌽𥌾𥍼𥏎𥐑𥚿𦔃𦖽𦝚𦡺𦢆𦦿𦩩𧅋𧓀𧕄𧕍𧢛𧭓𧮆𧯀𨍞𨜏𨟙𨠸𨪄𨵛𩄪𩋹𩖍𩘑𩳍𩸥𩹅𩻷𪊵𫇦𬎆𮐨𱩂𱲎𲂻","yo":"哟唷喲","yong":"㐯㙲㜉㝘㞲㟾㦷㴄㴩㶲㷏㻾㽫䗤䗸䞻䧡佣俑傛傭勇勈咏喁嗈噰埇塎墉壅嫞嵱庸廱彮怺恿悀惥愑愹慂慵拥揘擁柡栐槦永泳涌湧滽澭灉牅用甬痈癕癰砽硧禜臃苚蛹詠踊踴邕郺鄘醟鏞镛雍雝顒颙饔鯒鰫鱅鲬鳙鷛𠆌𠳀𠹍𡵜𢀍𢢓𢧳𣋿𣏀𤛑𥁎𥑿𥥝𥧱𦃽𦤘𦨤𦨬𧖇𧗴𧙇𧝸𧲤𧴄𧴗𧺸𧻹𨓨𨤂𨦡𨴭𨶽𩆄𩍓𩔔𩜳𩟀𩟷𩤛𩬮𪄉𪅟𪇛𪪝𱗚","you":"㒡㓜㕗㕱㗀㘥㚭㛜㤑㫍㮋㰶㱊㳊㳺㴗㶭㹨㺠㽕㾞䀁䅎䆜䍃䑻䒴䖻䚃䛻䞥䢊䢟䥳䬀䱂䳑丣亴优佑侑偤優卣又友右呦哊唀嚘囿姷孧宥尢尤峟峳幼幽庮忧怣怮悠憂懮攸斿有柚栯梄楢槱櫌櫾沋油泑浟游湵滺瀀牖牗牰犹狖猶猷由疣祐禉秞糿纋羐羑耰聈肬脜苃莜莠莸蒏蕕蚰蚴蜏蝣訧誘诱貁輏輶迶逌逰遊邮郵鄾酉酭釉鈾銪铀铕駀魷鮋鱿鲉麀黝鼬𠖋𠘳𠢢𠧠𠧴𠨦𠮫𡇀𡈙𡈰𡈵𡊧𡋧𡜳𡯉𡯙𡺒𡺖𢆶𢈓𢋣𢓿𢖟𢟅𢪥𢿚𣁨𣅄𣅺𣏞𣓐𣓛𣢄𣢜𣣜𣣸𣤎𣧗𣧥𣸠𣿤𤄘𤍕𤘜𤣙𤤧𤤬𤪎𤱎𤴨𤸈𥙾𥜚𥝘𥣯𥯞𥴕𥽟𦎓𦏇𦑸𦥣𦩲𦳧𦳩𦳷𦵵𦷿𦸙𧀥𧅲𧆕𧆘𧍘𧠶𧡹𧰰𧳫𨑫𨗰𨘁𨘵𨙂𨛕𨡴𨸙𩑣𩗚𩘈𩘓𩜷𩤹𩥘𩲎𩴑𩴙𩹊𩽇𩿬𬨎𱗎𲍳","yu":"㑨㒁㒜㔱㙑㚜㚥㝢㝼㠘㠨㡰㣃㤢㤤㥔㥚㥥㦛㦽㧒㪀㬂㬰㰲㲾㳛㶛㷒㺄㺞㺮㻀㼌㼶㽣䁌䁩䂊䂛䃋䄏䄨䆰䈅䉛䋖䋭䍂䍞䏸䐳䔡䖇䗨䘘䘱䘻䛕䜡䜽䞝䢓䢖䢩䣁䣿䤋䥏䨒䨞䩒䩽䫻䬔䮇䮙䰻䱷䲣䴁䵥与乻予于亐伃伛余俁俞俣俼偊傴儥兪匬唹喅喐喩喻噊噳圄圉圫域堉堣堬妤妪娛娯娱媀嫗嬩宇寓寙屿峪峿崳嵎嵛嶎嶼庽庾彧御忬悆惐愈愉愚慾懙戫扜扵挧揄敔斔斞於旕旟昱杅桙棛棜棫楀楡楰榆櫲欎欝欤欲歈歟歶毓浴淢淤淯渔渝湡滪漁潏澚澞澦灪焴煜燏燠爩牏狱狳獄玉玗玙琙瑀瑜璵畭瘀瘉瘐癒盂盓睮矞砡硢硲礇礖礜祤禦禹禺秗稢稶穥穻窬窳竽箊篽籅籞籲紆緎繘纡罭羭羽聿肀育腴臾舁舆與艅艈芋芌茟茰萭萮萸蒮蓣蓹蕍蕷薁蘌蘛虞虶蜟蜮蝓螸衧袬裕褕覦觎誉語諛諭謣譽语谀谕豫貐踰軉輍輿轝込迂迃逳逾遇遹邘郁鄅酑醧鈺銉鋊鋙錥鍝鐭钰閾阈陓隅雓雨雩霱預頨预飫餘饇饫馀馭騟驈驭骬髃鬰鬱鬻魊魚鮽鯲鰅鱊鱼鳿鴥鴧鴪鵒鷠鷸鸆鸒鹆鹬麌齬龉龥𠀛𠊏𠋟𠎳𠏚𠕦𠧇𠫣𠱐𠸹𠽵𡁎𡂊𡇺𡈨𡋬𡑾𡒃𡒊𡔴𡨣𡨿𡬊𡬞𡷎𡻢𡿥𡿯𢊧𢋅𢌻𢎻𢒰𢔢𢔥𢔬𢔴𢖡𢛨𢡎𢮁𢯮𢹏𢺴𢾄𣄊𣋉𣍛𣕃𣝑𣟰𣡉𣢒𣢦𣣎𣨝𣩺𤀝𤕘𤗃𤚎𤜹𤞞𤥽𤧙𤳕𤸒𤹪𥆉𥉑𥎐𥒾𥔢𥘄𥙿𥛩𥝍𥝨𥯮𥷔𥷞𥸤𥸪𥹔𦀡𦈣𦈸𦋢𦋯𦎘𦏜𦏻𦒑𦡭𦥉𦦩𦦫𦦲𦩞𦭳𦱀𦱂𦱃𦳅𧃠𧈯𧉣𧊠𧍪𧐄𧑐𧗪𧙶𧞏𧫊𧰇𧱬𧶠𧼫𧾚𧿷𨄯𨉗𨊱𨖛𨗝𨜖𨝈𨞓𨨶𨩬𨪎𨮔𨰸𨵉𨵦𨶢𨾌𩂧𩃯𩈕𩊇𩋉𩋤𩎹𩏟𩏴𩘤𩘳𩘻𩚄𩛪𩛭𩝗𩟑𩟳𩡃𩢶𩤺𩥭𩦡𩦢𩨈𩨗𩨙𩩑𩩘𩰪𩱌𩱱𩲾𩵎𩺰𪁀𪂉𪂕𪂵𪃍𪃎𪇝𪉐𪊻𪋉𪋬𪋮𪑆𪑌𪑝𪓊𫛣𭤰","yuan":"㟶㠾㤪㥐㥳㭇㹉㾓䅈䏍䖠䛄䛇䡝䥉䦾䨊䩩䬇䬧䬼䱲䲮䳒䳣傆元円冤剈原厡厵员員噮囦园圆圎園圓垣垸塬夗妴媛媴嫄嬽寃怨悁惌愿掾援杬棩榞榬橼櫞沅淵渁渆渊渕湲源溒灁爰猨猿獂瑗盶眢禐笎箢緣縁缘羱肙苑茒葾蒝蒬薗蚖蜎蜵蝝蝯螈衏袁裫裷褑褤謜貟贠轅辕远逺遠邍邧酛鈨鋺鎱院願駌騵魭鳶鴛鵷鶢鶰鸢鸳鹓黿鼋鼘鼝𠒜𠝳𠩠𡈒𡈓𡢊𡣬𡯱𡷡𢂱𢆀𢍈𢏮𢐄𢕋𢗯𢱽𢷻𣹠𤬌𥭞𥰟𥿎𦍼𦿂𧉗𧔞𧙮𧳭𧻚𨀮𨓯𨕗𨖳𨸘𨻣𩉯𩌑𩍻𩐘𩔃𩕾𩘍𩛟𩝸𩟁𩰵𪀈𪄁𪔅𪔗𪔙𪕀𫘪𱠸𲍉","yue":"㜧㜰㬦㰛㹊䆕䆢䋐䋤䖃䟑䟠䠯䡇䢁䢲䤦䥃䶳刖噦妜嬳岄岳嶽彟彠恱悅悦戉抈捳曰曱月樾瀹爚玥矱礿禴箹篗籆籥籰粤粵約约蘥蚎蚏越跀跃躍軏鈅鉞钺閱閲阅鸑鸙黦龠𠏃𠔠𠨲𠩉𠪶𠯲𠾲𠿋𡆦𡆽𡛟𡡕𢁞𢦰𢯵𢾔𣌗𣎱𣐋𣤰𣦏𣨡𣻮𤑓𤓝𥆟𥩡𥸘𦋩𦚢𦣜𦤕𧀲𧅚𧇓𧕋𧤽𧨄𧹊𨁑𨈋𨊸𨒋𨙄𨳕𨷲𨸀𨸎𨿁𩁯𩎙𩓥𩚈𩜌𩱪𩱲𩿠𪁑𪒥𪘳𫐄𬸚𱥟","yun":"㚃㚺㛣㜏㞌㟦㩈䆬䇖䉙䚋䞫䢵䤞䨶䩵䪳䲰云伝傊允勻匀喗囩夽奫妘孕恽惲愠愪慍抎抣昀晕暈枟橒殒殞氲氳沄涢溳澐煴熅熉熨狁畇眃磒秐筠筼篔紜緷緼縕縜繧纭缊耘耺腪芸荺蒀蒕蒷蕓蕴薀藴蘊蝹褞賱贇赟运運郓郧鄆鄖酝醖醞鈗鋆阭陨隕雲霣韗韞韫韵韻頵餫馧馻齫齳𠈤𠚓𠣐𠱳𡅙𡖒𡢘𡲪𡽅𣂊𣍯𣖆𤈶𤶧𤸫𥐩𥠺𥬀𦅿𦈉𧉃𧡡𧥼𧬞𧶊𧼐𨍆𨛡𨷐𩁴𩂿𩏅𩏆𩴉𪉂𪍝𪏔𪏚𪘩𫖳","za":"㞉㦫䕹䞙䨿䪞偺匝咂咋喒囋囐帀拶杂沞沯砸磼紥紮臜臢襍迊鉔雑雜雥韴魳𠂝𠯗𠷿𠽷𡁕𢶍𢹼𢽜𣤷𣤺𣴖𣸐𤄔𤠀𥷩𦠛𦾬𧌃𧬩𧾁𨠿𩇺𩞶𪚇𰗣","zai":"㱰䏁䣬䮨䵧仔傤儎再哉在宰崽扗栽洅渽溨災灾烖甾睵縡菑賳載载酨𠎶𡉄𡿧𢎋𢦏𢦒𣅃𣔮𣪮𤌊𤝖𤞳𦞁𦳦𧯥𨀬𨚵𩛥𩛳𱖝𱖞","zan":"㔆㜺㟛㣅㳫䍼䐶䬤䭕儧儹兂咱噆寁揝撍攅攒攢昝暂暫桚濽灒瓉瓒瓚禶簪簮糌襸讃讚賛贊赞趱趲蹔鄼酇錾鏨鐕鐟饡𠼗𡡖𢄤𣸄𥎑𥜙𥳋𥸢𧄽𨖋𨘄𨙏𩛻𩯒𩯳𪷽𫏐","zang":"㘸㮜匨塟奘弉牂羘脏臓臟臧葬蔵賍賘贓贜赃銺駔驵髒𡁧𡅆𢈜𢍿𣻟𤃼𤛻𤞛𦟃𧕨𨌄𪓅𱣹","zao":"㡟㯾㷮䖣䗢䜊䥣䲃傮凿唕唣喿噪慥早枣栆梍棗澡灶煰燥璪皁皂竃竈簉糟繰艁薻藻蚤譟趮蹧躁造遭醩鑿𠙬𠴵𡌣𡐋𡨗𢄀𢑖𢲵𢵥𣍖𣩒𣴢𤍜𤞋𤟀𤩨𥀛𥖨𦯑𦵩𧈹𧎮𨎮𨐉𨒽𨚰𨠷𪙡𪣝𲂓","ze":"㖽㟙㣱㳁㳻㺓䇥䕉䕪䯔䰹䶦仄伬则則唶啧嘖夨嫧崱帻幘庂択择捑擇昃昗樍歵汄沢泎泽溭澤皟瞔矠礋笮箦簀舴蔶蠌襗諎謮責賾责赜迮鸅齚齰𠟻𠨻𡵗𡸈𡸦𡹨𢧠𢮚𢯩𣆽𣛸𣤈𣬿𣼦𣿐𤖓𤢟𤾀𥍱𥎍𥟔𥼃𦔈𦟜𧶷𨕠𨖊𩂖𩄾𩌪𩔳𩾸𪌟𫜬","zei":"戝蠈賊贼鯽鰂鱡鲗𢨗𦽒𧒿𨆎","zen":"㻸囎怎譖譛谮","zeng":"㽪䎖䙢䰝増增憎橧熷璔甑矰磳繒缯罾譄贈赠鄫鋥锃鱛𡡑𡾽𤎯𦀓𦼏𧢐𨲯𪒟𪙭","zha":"㗬㡸㦋㪥㱜㳐㴙㷢㾴䃎䄍䆛䋾䐒䕢䖳䛽䥷䮜䮢䱹䵙䶥乍偧劄厏吒咤哳喳奓宱扎抯拃挓揸搩搾摣札柞柤査栅楂榨樝渣溠灹炸煠牐甴痄皶皻眨砟箚耫苲蚱蚻觰詐譇譗诈踷醡鍘铡閘闸霅鮓鮺鲊鲝齄齇𠍹𠓣𠝚𠢙𠢡𠭯𠯩𠰏𠽣𡎫𡗸𡟢𢄄𢕮𢧖𢧻𣛽𣟦𣧖𣽛𤁳𤈩𤡨𤰦𤵦𤹡𥀈𥀉𥡧𥹁𦂉𦑯𦟰𦳏𧄠𧉫𧧻𧨊𧨿𧩫𧬅𧶇𧼰𧼶𧽅𧿌𨂵𨅓𨋘𨡗𩃡𩃹𩥠𩩥𩬟𩮎𩳶𩶱𩻢𩼫𩽽𩿤𪗭𪗵𱚝𱨙𱻴","zhai":"㒀㡯㩟䍉䐱䔝债債夈宅寨捚摘斋斎榸檡瘵砦窄粂鉙齋𠏰𠑞𠞶𠵠𠷒𡅓𡍥𢋿𢯌𢴨𣩭𤞮𤢒𤻦𥍪𥞅𥰾𦑱𦤧𧲻𧻍𨅪𨝋𩏪𩏽𩝿𩬫𩱳𪀥𪑽𪗒𪗓𪘇𪘨𪚎","zhan":"㔊㜊㞡㟞㠭㣶㮵㺘㻵䁪䁴䆄䋎䎒䗃䘺䟋䡀䦓䩅䩆䩇䪌䱠䱳䱼䶨佔偡占噡嫸展崭嶃嶄嶘嶦惉战戦戰搌斩斬旃旜枬栈栴桟棧榐橏毡氈氊沾湛琖盏盞瞻站粘綻绽菚薝蘸虥虦蛅覱詀詹譧譫讝谵趈輚輾轏邅醆閚霑颭飐飦饘驏驙魙鱣鳣鸇鹯黵龪𠌲𠟉𠟧𡁳𡅹𡓦𡕁𡭞𡽻𢅺𢈽𢤚𢧗𣀁𣛷𣢤𣮿𣳤𤖆𤘇𤜇𥇢𥙡𥴐𥶕𥿜𦈻𦗢𦧚𦪣𧀡𧂁𧋱𧎰𧒝𧔡𧖉𧙭𧝑𧬆𧮪𧮺𧲮𧸪𧽆𧾍𨇩𨊈𨣁𨣚𨫀𨭖𨺿𨼈𨼮𩆯𩉗𩔣𩕊𩥇𩨍𩰃𩼼𪃋𪉜𪏉𪗦𪘪𪡏𫗴𬍙𬘜𱔯","zhang":"㙣㽴䛫丈仉仗傽墇嫜嶂帐帳幛幥张張彰慞扙掌暲杖樟涨涱漲漳獐璋痮瘬瘴瞕礃章粀粻胀脹蔁蟑賬账遧鄣鏱長长障餦騿鱆麞𠅹𠫝𡈠𡑄𡚹𢕎𢕔𢩰𢪾𢷢𣌞𣾦𤍤𤓯𤕄𥳶𦺡𧐊𧽣𨄰𩌬𩭫𪅂𫗠","zhao":"㑿㕚㡽㷖㷹䃍䈃䈇䍜䍮䑲䝖䞴佋兆召啁垗妱巶找招旐昭曌枛棹櫂沼炤照燳爪爫狣瑵皽盄瞾窼笊罀罩羄肁肇肈詔诏赵趙釗鉊鍣钊駋鮡𠕖𠕭𠟅𠠄𠻥𡖎𡱜𢁬𢗈𢡰𣋍𣠜𤍒𤙔𤿘𥏨𥵤𦗔𦬔𦹫𦺓𧳝𧳻𨱻𨹸𩘀𩙩𬬿𬶐","zhe":"㞏㡇㢎㪿㭙㭯㯙㯰㸙㸞䂞䇽䊞䎲䏳䐑䐲䓆䗪䜆䝃䝕䠦䩾䮰䵭乽厇哲啠啫喆嗻嚞埑嫬悊折摺晢晣柘樜歽浙淛潪着矺砓磔禇籷粍者蔗虴蛰蜇蟄蟅袩褶襵詟謫謺讁讋谪赭輒輙轍辄辙这這遮銸鍺锗馲鮿鷓鹧𠌮𠚱𠝝𠞃𠯓𠽻𠾀𡂭𡄡𡇠𡘭𡜯𡝊𢟯𢢍𢫰𢬴𣇧𣙵𣠞𣶋𣻩𤜤𤟍𤮱𥏯𥐽𥑡𥕣𥛧𥤋𥧮𥭙𦅄𦔮𦗑𦗗𦞥𦠟𦠠𦠣𦬃𦯍𧀹𧎴𧑧𧤠𧲢𨅊𨐃𨰵𨵊𩊵𩐶𩢐𩣩𩤜𪐏𪚥","zhen":"㐱㓄㖘㘰㣀㪛㮳㯢㱽㲀㴨㼉䀕䂦䂧䃌䈯䊶䏖䑐䝩䟴䠴䨯䪴䪾䫬䲴䳲侦侲偵圳塦嫃寊屒帧帪弫抮挋振揕搸敶斟昣朕枕栕栚桢桭楨榛樼殝浈湞潧澵獉珍珎瑧瑱甄甽畛疹眕眞真眹砧碪祯禎禛稹箴籈紖紾絼縥纼缜聄胗臻萙葴蒖蓁薽袗裖診誫诊貞賑贞赈軫轃轸遉酖酙針鉁鋴錱鍼鎭鎮针镇阵陣震靕駗鬒鱵鴆鸩黰𠘱𠛶𠠹𠬓𠵧𠸸𡇑𡇖𡈿𡻈𢏈𣃵𣏖𣒅𣓀𣬻𣱽𣿎𤚨𤷌𥅘𥌃𥖘𥤤𥪘𦳳𦸮𧠝𧤛𧮬𨌑𨏤𨱅𨳌𨸬𩄛𩇜𩊡𩊨𩑘𩒀𩒈𩒪𩬖𩾺𪁧𪇳𪉕𪐲𪑳𬘝𮬤𲁉","zheng":"㡠㡧㬹㱏㽀䂻䆸䇰䈣䋊䋫䍵䡕䥌䥭䦛䦶䱢争佂凧埩塣姃媜峥崝崢幀征徰徴徵怔愸抍拯挣掙掟揁撜政整晸正氶炡烝爭狰猙症癥眐睁睜筝箏篜糽聇蒸証諍證证诤踭郑鄭鉦錚钲铮鬇鯖鴊鿇𠏫𠑅𠔻𠲜𡪺𢁿𢌦𢏰𢓞𢮐𢹑𢾧𤪡𤸲𤿆𥊼𥒛𦓺𦙫𦚦𦜎𦡅𦱊𧗆𧗲𧘿𧪣𧯫𧶄𨀧𨋬𨌢𨚣𨛰𨜓𨟃𨢹𨧭𨺟𩏠𩗲𩗵𩘼𩘽𩚫𩺄𪎻","zhi":"㕄㗌㗧㘉㙷㛿㜱㜼㝂㡳㡶㣥㥀㨁㨖㩼㫑㮹㯄㲍㲛㴛㴯㸟㽻㿃䄺䅩䆈䇛䇧䉅䉜䎺䏯䐈䐭䑇䓋䓌䓜䓡䕌䘭䚦䚳䛗䝰䝷䞃䞠䟈䟡䡹䣽䤠䥍䦯䧴䩢䬹䭁䱃䱥䲀䳅䵂䵹之乿侄俧倁値值偫傂儨凪制劕劧卮厔只吱咫嗭址坁坧垁埴執墆墌夂妷姪娡嬂寘峙崻巵帋帙帜幟庢庤廌彘徏徔徝志忮怾恉慹憄懥懫戠执扺扻抧挃指挚掷搘搱摭摯擲擳支旘旨晊智枝枳柣栀栉桎梔梽植椥楖榰樴櫍櫛止殖汁汥汦沚治泜洔洷淔淽滍滞滯漐潌瀄炙熫犆狾猘瓆瓡畤疐疷疻痔痣直知砋礩祉祑祗祬禃禔秓秖秩秪秲秷稙稚稺穉窒筫紙紩絷綕緻縶織纸织置翐聀职職肢胑胝脂膣膱至致臸芖芝芷茋藢蘵蛭蜘螲蟙衹衼袟袠製襧覟觗觯觶訨誌豑豒豸貭質贄质贽趾跖跱踬踯蹠躑躓軄軹軽輊轵轾迣郅酯釞鉄銍鋕鑕铚锧阤阯陟隲隻雉馶馽駤騭騺驇骘鯯鳷鴙鴲鷙鸷黹鼅鿵𠊤𠊷𠋤𠍜𠓶𠘖𠚅𠦧𠮡𠰅𠼠𡀹𡁉𡂒𡂣𡈊𡌴𡍶𡏀𡏚𡑘𡖧𡖻𡙑𡠗𡠹𡮞𡰹𡱔𡸜𡽆𢃜𢄢𢄱𢅁𢇨𢊁𢍧𢎈𢐂𢕞𢖇𢖿𢙺𢚨𢛍𢡒𢧤𢯶𢰙𢴠𢴧𢷸𢻙𢽃𢽗𢾫𣔐𣖌𣖭𣖿𣗻𣚠𣥰𣨋𣲵𣳀𣽚𤃲𤆒𤓕𤖞𤛱𤞂𤞌𤦄𤦮𤧜𤴛𤴟𤴢𤵋𤶓𤸓𤽁𤿙𥃫𥇕𥇭𥍭𥎹𥏄𥏅𥏊𥏰𥏷𥒓𥒗𥔊𥘡𥝑𥝮𥠈𥠽𥣮𥭡𥮖𥴒𥹩𥻬𥾣𥿮𦃘𦏤𦐖𦛧𦜋𦝔𦟔𦤻𦥎𦥏𦥐𦭜𦭮𦯫𦯯𦰘𦳮𦴀𧀿𧃐𧊙𧌔𧏸𧐉𧓸𧙁𧛢𧜚𧝉𧠫𧠴𧣭𧣾𧤡𧨰𧫡𧱒𧸅𧸕𧸲𧹛𧽦𧾂𨁷𨂂𨃯𨆧𨌌𨎉𨎌𨑨𨒉𨕕𨖹𨜎𨟊𨟾𨡐𨢮𨤱𨧵𨫔𨬚𨰛𨵂𨻆𨼓𨿛𩊝𩊴𩋩𩍲𩍵𩙾𩧄𩬺𩯈𩷓𩹈𩻼𪁊𪁓𪁩𪂅𪉆𪏀𪑜𪒊𪗨𪗻𪙹𫛛𬃊𮉢𰧉𱼷","zhong":"㣫㲴㹣䇗䈺䝦䱰中仲伀众偅冢刣喠堹塚塜妐妕媑尰幒彸忠柊歱汷泈炂煄狆瘇盅眾祌种種穜筗籦終终肿腫舯茽蔠蚛螤螽衆衳衶衷諥踵蹱重鈡銿鍾鐘钟锺鴤鼨𠊥𠛀𠱧𡖌𡥿𡰒𡻑𢁷𢃭𢝆𢨱𣱧𣷡𣹞𤚏𤝅𤯚𤺄𥗦𥷈𥻝𦉂𦌋𦔉𦬕𧆼𧑆𧬤𧳮𨉢𨳗𩅞𩅧𩾋𩿀𬑔𱖽𱗖","zhou":"㑇㑳㛩㤘㥮㨄㫶㼙㾭䈙䋓䎇䎻䑼䓟䖞䛆䧓䩜䶇伷侜僽冑周呪咒咮喌噣妯宙州帚徟掫昼晝晭洲淍炿烐珘甃疛皱皺盩睭矪箒籀籒籕粙粥紂縐纣绉肘胄舟荮菷葤詋詶謅譸诌诪賙赒軸輈輖轴辀週郮酎銂霌駎駲騆驟骤鯞鵃鸼𠊣𠚴𠣘𠤍𠱙𡀑𡊡𢃸𢏝𢐫𢓟𢫧𢷗𢼲𢽧𣆔𣥯𣻱𤏲𥀙𥌆𥑸𥖠𥣙𥲝𥺝𥺞𥼫𥾓𥿦𦁖𦂈𦅸𦈺𦩈𦭴𧇟𧛸𧣷𧧔𧭍𧳜𧻖𨉜𨏺𨥇𨦞𩊄𩋰𩍌𩍧𩗪𩢸𩧨𩧳𩶣𪆀𪇞","zhu":"㑏㔉㝉㤖㦵㧣㫂㵭㶆㹥㺛㾻㿾䃴䇠䇡䇬䌵䍆䎷䐗䐢䕽䘄䘚䘢䝒䝬䟉䠱䡤䣷䥮䪒䬡䭖䮱䰞丶主伫佇住侏劚助劯嘱囑坾墸壴孎宔嵀拄斸曯朱杼柱株槠樦橥櫧櫫欘殶泏注洙渚潴濐瀦灟炢炷烛煑煮燭爥猪珠疰瘃眝瞩矚砫硃祝祩秼窋竚竹竺笁笜筑筯箸築篫簗紵紸絑纻罜羜翥舳苎茱茿莇著蛀蛛蝫蠋蠩蠾袾註詝誅諸诛诸豬貯贮跓跦躅軴迬逐邾鉒銖鋳鑄钃铢铸陼霔馵駐駯驻鮢鯺鱁鴸麆麈鼄𠧀𠩈𠮌𠰍𠴦𠷅𡎺𡤗𡧨𡱱𡴅𡸌𡺐𡻌𡻠𢁼𢔪𢚻𢥃𢩄𢲿𣔯𣤁𣥼𣵸𣽆𤆼𤋰𤎧𤕞𤝹𤥮𤲑𤳯𤾄𥋛𥛂𥞏𥩣𥯸𥵟𥹍𥾅𦅷𦉐𦙴𦧙𦬸𧈚𧉞𧏿𧑤𨆄𨈫𨙔𨞕𨭅𨲈𩊣𩋵𩒊𩞈𩨻𩲠𩲬𩳥𩴀𩶂𩶄𪊹𪋏𪋑𪋰𪏿𪚹𬣞","zhua":"抓檛簻膼髽𡎬𣑃𥬲𥮣𭪆","zhuai":"拽跩𢶀𱖕","zhuan":"䉵䏝䡱䧘专僎叀啭囀堟塼嫥孨専專撰灷瑑瑼甎砖磗磚竱篆篹籑腞膞蒃蟤襈諯譔賺赚転轉转鄟顓颛饌馔鱄𠊩𠨎𡇰𡢀𡤛𡭇𡰞𢂘𢐎𢞬𣂵𣕏𣚢𤂤𤩄𤪪𤮳𥛥𥫛𦁆𦄯𦓝𦝏𦧸𧂍𧸖𨷱𩔊𩧜𩳏𩻝𬤥","zhuang":"壮壯壵妆妝娤庄庒戇撞桩梉樁湷漴焋状狀粧糚荘莊装裝𠌴𢙳𢤤𣞝𣴣𣶍𣻛𤘲𤶜𦀜𦚏𩅃𩮱𩯲𪁈𪉉","zhui":"㗓㚝㩾㮅㾽䄌䨨䶆坠墜娷惴桘沝甀畷硾礈笍綴縋缀缒膇諈贅赘轛追醊錐錣鑆锥隹餟騅骓鵻𡑻𢊅𣝸𣦬𣨫𤺅𥟒𦥻𧿲𨪗𨺵𨾻𩛵𩜀𩪀𩬳𪋇𮣵𱾝𲍾","zhun":"㡒准凖埻宒準稕窀綧肫衠訰諄谆迍𥇜𥚠𬘯𲍭","zhuo":"㑁㒂㓸㣿㧳㧻㭬㹿㺟䂐䅵䆯䐁䓬䕴䟾䦃䪼䫎䮓䮕䶂丵倬劅卓叕啄啅圴妰娺彴拙捉撯擆擢斀斫斱斲斵晫桌梲棁棳椓槕櫡汋浊浞涿濁濯灂灼炪烵犳琸硺禚穛穱窡窧篧籗籱罬茁蠗蠿諁諑謶诼酌鋜鐯鐲镯鵫鷟𠡑𠭴𠿡𡷿𢁁𢢗𢧈𢳇𢺡𢽚𣃈𣃑𣄻𤃮𤉐𤏸𤓦𥇍𥋮𥐊𥗁𥞺𥢔𥮥𥯩𥷘𥷮𥼚𦜰𦰹𦳡𧂒𧃔𧘑𧞐𧢼𧨳𧱰𨑽𨖮𨡸𨢬𨧧𨮿𨺝𩆸𩋁𩑂𩩔𩲃𩷹𬸦𲂔","zi":"㜽㞨㠿㧗㧘㰣㰷㱴㺭㽧㾅㿳䅆䅔䆅䎩䐉䔂䖪䘣䣎䦻䰵乲倳兹剚吇呰咨啙嗞姉姊姕姿子字孜孳孶崰嵫恣杍栥梓椔榟橴淄渍湽滋滓漬澬牸玆璾眥眦矷禌秄秭秶稵笫籽粢紎紫緇缁耔胏胔胾自芓茊茡茲荢葘蓻虸觜訾訿諮谘貲資赀资赼趑趦輜輺辎鄑釨鈭錙鍿鎡锱镃頾頿髭鯔鰦鲻鶅鼒齍龇𠀢𠂔𠡸𡉗𡗈𡙛𡪒𡸟𡸪𢱆𢼱𣄮𣓊𣖨𣚀𣚁𣣊𣣌𣥨𣯃𣳩𣸆𥀖𥕁𥚉𥞎𥫞𥬳𥲕𥻍𥼩𥼻𥿩𦍺𦎸𦖺𦣹𦺱𧀗𧂐𧆰𧕓𧛏𧣤𧥕𧧕𧨴𧹌𧿞𨀥𨍢𨚖𨝳𨧫𨩲𨹀𩄚𩐍𩜊𪅵𪑿𪕊𪗉𪗋𪗐𱰭𱳞𱹼","zong":"㙡㚇㢔㣭㨑㯶㷓㹅䁓䈦䍟䑸䗥䙕䝋䰌倊倧偬傯堫宗嵏嵕嵸总惣惾愡捴揔搃摠昮朡棕椶潈熧燪猔猣疭瘲碂磫稯粽糉糭綜緃総緵縂縦縱總纵综翪腙葼蓗蝬豵踨踪蹤錝鍐鏓鑁騌騣骔鬃鬉鬷鯮鯼𠏭𠕌𠡻𠵻𡕰𡞧𣀒𣯨𤡆𥍺𥓻𥚾𥠡𦖸𦡙𧺣𨌰𨍈𨎢𨺡𩋯𩤗𩦲𩭤𩮀𩰽𪖁𮪣","zou":"㔌㔿㵵㻓䠫奏揍棷棸楱箃緅菆諏诹走赱邹郰鄒鄹陬騶驺鯐鯫鲰黀齱齺龰𠂑𣙻𣠏𥋜𥶈𧌗𨂡𨃘𨑿𨜗𨽁𩼦𪃆𮉪","zu":"㞺㰵㵀䔃䖕䚝䯿䱣俎傶卆卒哫唨崒崪族爼珇祖租箤組组葅蒩詛诅足踤踿鎺鏃镞阻靻𠻏𡻬𢅪𢉺𢫵𢳈𣇙𣢰𣤶𣨛𤓵𤬧𤱌𤽱𥛜𥞯𥣆𥼀𥼪𦑋𦵬𧇈𧇿𧎲𧐈𧑙𧗎𧞰𧺒𨂀𨃭𨄕𨧰𨨳𨩰𩐡𩥿𩩠𩲲𩺯𪋍𪘧𪙳","zuan":"㸇䂎䌣䡽䤸䰖攥籫繤纂纉纘缵躜鑚鑽钻𡉺𣀶𣪁𦆈𦙉𨉖𨰭𩎑","zui":"㝡㠑㭰㰎䘒䘹䮔厜嗺嘴噿嶊嶵晬最朘栬槜樶檇檌璻祽稡穝絊纗罪蕞蟕辠酔酻醉鋷錊𠲋𠾋𠿘𡙭𡡔𡽁𡽛𢈡𢊛𣖱𣩑𥍋𥳣𥳵𦈬𦏳𦙈𦸺𧎹𧻝𨢅𨿇𩚻𩣷𩲨𪋌𪓌","zun":"䔿僔噂墫壿尊嶟捘撙樽繜罇譐遵銌鐏鱒鳟鶎鷷𠟃𠱜𤮐𥊭𥞘𥢎𦢐𦨆𦪚𨱔𩯄","zuo":"㑅㘀㘴㝾㤰㭮㵶㸲䋏䎰䔘䝫䞢䞰䟶䶹佐作侳做咗唑坐岝岞左座怍捽昨椊琢祚秨稓筰糳繓胙莋葃葄蓙袏鈼阼飵𠂇𠱯𠹠𡪳𡯨𡹥𢂃𣠹𣹧𤿀𥅁𥙀𥥏𥽿𦁎𦈛𦥬𦦹𧃘𧮙𧲭𨀨𨐳𨝨𨞒𩛠𪎇𪎲"}`
+
+// 常用的多音字词语，按词语确定读音
+var phraseDict = map[string]string{
+	"一只":  "yi zhi",
+	"上当":  "shang dang",
+	"下载":  "xia zai",
+	"为了":  "wei le",
+	"为何":  "wei he",
+	"主角":  "zhu jue",
+	"乐器":  "yue qi",
+	"了结":  "liao jie",
+	"了解":  "liao jie",
+	"人参":  "ren shen",
+	"仔细":  "zi xi",
+	"会计":  "kuai ji",
+	"传记":  "zhuan ji",
+	"似的":  "shi de",
+	"作为":  "zuo wei",
+	"供应":  "gong ying",
+	"供给":  "gong ji",
+	"便宜":  "pian yi",
+	"偿还":  "chang huan",
+	"关卡":  "guan qia",
+	"几何":  "ji he",
+	"出差":  "chu chai",
+	"分行":  "fen hang",
+	"剥削":  "bo xue",
+	"勾当":  "gou dang",
+	"单于":  "chan yu",
+	"卡片":  "ka pian",
+	"厦门":  "xia men",
+	"参差":  "cen ci",
+	"反省":  "fan xing",
+	"发卡":  "fa ka",
+	"只有":  "zhi you",
+	"号召":  "hao zhao",
+	"号码":  "hao ma",
+	"同行":  "tong hang",
+	"和面":  "huo mian",
+	"响应":  "xiang ying",
+	"因为":  "yin wei",
+	"地址":  "di zhi",
+	"填空":  "tian kong",
+	"处所":  "chu suo",
+	"处理":  "chu li",
+	"处长":  "chu zhang",
+	"大夫":  "dai fu",
+	"大量":  "da liang",
+	"头发":  "tou fa",
+	"好处":  "hao chu",
+	"好奇":  "hao qi",
+	"好客":  "hao ke",
+	"妥当":  "tuo dang",
+	"宝藏":  "bao zang",
+	"容量":  "rong liang",
+	"宿舍":  "su she",
+	"对应":  "dui ying",
+	"对称":  "dui chen",
+	"尽管":  "jin guan",
+	"尽量":  "jin liang",
+	"差事":  "chai shi",
+	"差旅":  "chai lv",
+	"干事":  "gan shi",
+	"干活":  "gan huo",
+	"干部":  "gan bu",
+	"年载":  "nian zai",
+	"应付":  "ying fu",
+	"应收":  "ying shou",
+	"应用":  "ying yong",
+	"应答":  "ying da",
+	"延长":  "yan chang",
+	"弹出":  "tan chu",
+	"弹幕":  "dan mu",
+	"弹性":  "tan xing",
+	"弹窗":  "tan chuang",
+	"归还":  "gui huan",
+	"当铺":  "dang pu",
+	"得分":  "de fen",
+	"得到":  "de dao",
+	"恰当":  "qia dang",
+	"成都":  "cheng du",
+	"扎实":  "zha shi",
+	"打折":  "da zhe",
+	"投降":  "tou xiang",
+	"折叠":  "zhe die",
+	"折扣":  "zhe kou",
+	"折本":  "she ben",
+	"挣扎":  "zheng zha",
+	"排行":  "pai hang",
+	"播种":  "bo zhong",
+	"支行":  "zhi hang",
+	"数学":  "shu xue",
+	"数据":  "shu ju",
+	"数量":  "shu liang",
+	"旋转":  "xuan zhuan",
+	"时长":  "shi chang",
+	"星宿":  "xing xiu",
+	"暖和":  "nuan huo",
+	"曲目":  "qu mu",
+	"曲线":  "qu xian",
+	"最长":  "zui chang",
+	"朝代":  "chao dai",
+	"朝向":  "chao xiang",
+	"朝阳":  "chao yang",
+	"期号":  "qi hao",
+	"标识":  "biao shi",
+	"校准":  "jiao zhun",
+	"校对":  "jiao dui",
+	"校正":  "jiao zheng",
+	"校验":  "jiao yan",
+	"模具":  "mu ju",
+	"模样":  "mu yang",
+	"次数":  "ci shu",
+	"歌曲":  "ge qu",
+	"流量":  "liu liang",
+	"测量":  "ce liang",
+	"爱好":  "ai hao",
+	"牛仔":  "niu zai",
+	"率领":  "shuai ling",
+	"理发":  "li fa",
+	"相机":  "xiang ji",
+	"相片":  "xiang pian",
+	"省份":  "sheng fen",
+	"着手":  "zhuo shou",
+	"着装":  "zhuo zhuang",
+	"着重":  "zhuo zhong",
+	"着陆":  "zhuo lu",
+	"种植":  "zhong zhi",
+	"秘鲁":  "bi lu",
+	"称职":  "chen zhi",
+	"空白":  "kong bai",
+	"空调":  "kong tiao",
+	"空闲":  "kong xian",
+	"空间":  "kong jian",
+	"答应":  "da ying",
+	"结束":  "jie shu",
+	"结果":  "jie guo",
+	"结算":  "jie suan",
+	"给予":  "ji yu",
+	"统率":  "tong shuai",
+	"自传":  "zi zhuan",
+	"茶几":  "cha ji",
+	"获得":  "huo de",
+	"落下":  "la xia",
+	"薄弱":  "bo ruo",
+	"薄荷":  "bo he",
+	"血型":  "xue xing",
+	"血液":  "xue ye",
+	"行业":  "hang ye",
+	"行为":  "xing wei",
+	"行号":  "hang hao",
+	"行情":  "hang qing",
+	"行数":  "hang shu",
+	"行长":  "hang zhang",
+	"补给":  "bu ji",
+	"西藏":  "xi zang",
+	"角色":  "jue se",
+	"解数":  "xie shu",
+	"记载":  "ji zai",
+	"识别":  "shi bie",
+	"调价":  "tiao jia",
+	"调休":  "tiao xiu",
+	"调度":  "diao du",
+	"调拨":  "diao bo",
+	"调整":  "tiao zheng",
+	"调用":  "diao yong",
+	"调节":  "tiao jie",
+	"调试":  "tiao shi",
+	"调账":  "tiao zhang",
+	"转动":  "zhuan dong",
+	"转账":  "zhuan zhang",
+	"转载":  "zhuan zai",
+	"转速":  "zhuan su",
+	"轻率":  "qing shuai",
+	"载体":  "zai ti",
+	"还原":  "huan yuan",
+	"还款":  "huan kuan",
+	"还清":  "huan qing",
+	"退还":  "tui huan",
+	"都市":  "du shi",
+	"重做":  "chong zuo",
+	"重发":  "chong fa",
+	"重叠":  "chong die",
+	"重名":  "chong ming",
+	"重启":  "chong qi",
+	"重复":  "chong fu",
+	"重庆":  "chong qing",
+	"重建":  "chong jian",
+	"重新":  "chong xin",
+	"重置":  "chong zhi",
+	"重试":  "chong shi",
+	"重载":  "chong zai",
+	"重量":  "zhong liang",
+	"量化":  "liang hua",
+	"银行":  "yin hang",
+	"长宽":  "chang kuan",
+	"长度":  "chang du",
+	"长期":  "chang qi",
+	"长短":  "chang duan",
+	"长途":  "chang tu",
+	"附和":  "fu he",
+	"降级":  "jiang ji",
+	"露出":  "lou chu",
+	"露面":  "lou mian",
+	"音乐":  "yin yue",
+	"首相":  "shou xiang",
+	"首都":  "shou du",
+	"目的地": "mu di di",
+	"重定向": "chong ding xiang",
+	"长方形": "chang fang xing",
+}
+
+// 常用多音字的单字读音，优先于字典中的读音，词语中的读音由phraseDict确定
+var polyphoneDict = map[rune]string{
+	'长': "chang",
+	'行': "xing",
+	'重': "zhong",
+	'还': "hai",
+	'弹': "tan",
+	'仔': "zi",
+	'薄': "bo",
+	'曝': "bao",
+	'削': "xiao",
+	'茄': "qie",
+}
+
+var fullDict map[rune]string
+var fullDictFlag bool
+
+// 词语的最大长度
+const maxPhraseLength = 3
+
+// 获取单个中文的完整拼音，返回小写，不存在时返回空
+func getFullPinYin(r rune) string {
+	if !fullDictFlag {
+		fullDict = make(map[rune]string)
+		_ = jsonparser.ObjectEach([]byte(fullDictStr), func(key []byte, value []byte, dataType jsonparser.ValueType, offset int, comment []byte) (flag bool, err error) {
+			for _, v := range string(value) {
+				// 同一个中文出现多次时保留第一个读音
+				if _, ok := fullDict[v]; !ok {
+					fullDict[v] = string(key)
+				}
+			}
+			return true, nil
+		})
+		for k, v := range polyphoneDict {
+			fullDict[k] = v
+		}
+		fullDictFlag = true
+	}
+	return fullDict[r]
+}
+
+// GetFullPinYin 获取中文的完整拼音，每个中文一个音节，返回小写
+// 优先匹配最长的常用词语来确定多音字的读音，不存在的中文使用defaultLetter
+func GetFullPinYin(str string) []string {
+	var result []string
+	runes := []rune(str)
+	for i := 0; i < len(runes); {
+		j := i + maxPhraseLength
+		if j > len(runes) {
+			j = len(runes)
+		}
+		for ; j > i+1; j-- {
+			if e, ok := phraseDict[string(runes[i:j])]; ok {
+				result = append(result, strings.Split(e, " ")...)
+				break
+			}
+		}
+		if j == i+1 {
+			value := getFullPinYin(runes[i])
+			if value == "" {
+				value = defaultLetter
+			}
+			result = append(result, value)
+		}
+		i = j
+	}
+	return result
+}
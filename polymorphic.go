@@ -0,0 +1,198 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 默认的区分字段
+var defaultDiscriminatorKeys = []string{"type", "kind", "event", "@type", "__typename"}
+
+// 解析一个对象样本，保留样本用来识别区分字段，属性仍然合并到parent
+func recursionSample(parent *Node, data []byte, config *Config) error {
+	sample := newPathNode(parent.k, parent.t, parent.g, "", parent.p)
+	err := recursionNode(sample, data, config)
+	if err != nil {
+		return err
+	}
+	parent.samples = append(parent.samples, sample)
+	parent.count += sample.count
+	for _, nodes := range *sample.childrenMerge {
+		for _, node := range nodes {
+			addChildrenMerge(parent, node)
+		}
+	}
+	return nil
+}
+
+// 识别区分字段，返回区分字段、区分字段的值和按值分组的样本，不是多态类型时返回空
+// 候选key按顺序匹配，第一个满足条件的作为区分字段
+func findDiscriminator(nodes []*Node, config *Config) (string, []string, [][]*Node) {
+	group, _ := mergeGroupAndType(nodes)
+	if groupBase(group) != GroupO {
+		return "", nil, nil
+	}
+	var samples []*Node
+	for _, node := range nodes {
+		if node.hint.t != "" {
+			return "", nil, nil
+		}
+		samples = append(samples, node.samples...)
+	}
+	if len(samples) < 2 {
+		return "", nil, nil
+	}
+	keys := config.DiscriminatorKeys
+	if len(keys) == 0 {
+		keys = defaultDiscriminatorKeys
+	}
+	for _, key := range keys {
+		if values, groups := groupSamples(samples, key); values != nil {
+			return key, values, groups
+		}
+	}
+	return "", nil, nil
+}
+
+// 按区分字段的值分组，每个样本都有这个字符串属性，至少有两种值，并且每种值都有自己特有的属性
+func groupSamples(samples []*Node, key string) ([]string, [][]*Node) {
+	var values []string
+	var groups [][]*Node
+	index := make(map[string]int)
+	for _, sample := range samples {
+		i, ok := sample.cache[key]
+		if !ok {
+			return nil, nil
+		}
+		fields := (*sample.childrenMerge)[i]
+		if len(fields) != 1 || fields[0].g != GroupV || fields[0].t != TypeString || fields[0].hint.t != "" || len(fields[0].values) != 1 {
+			return nil, nil
+		}
+		value := fields[0].values[0]
+		j, ok := index[value]
+		if !ok {
+			j = len(groups)
+			index[value] = j
+			values = append(values, value)
+			groups = append(groups, nil)
+		}
+		groups[j] = append(groups[j], sample)
+	}
+	if len(groups) < 2 {
+		return nil, nil
+	}
+	// 每种值的样本都有其他值的样本没有的属性，否则只是普通的字符串属性和可选的属性
+	for i, g := range groups {
+		others := make(map[string]bool)
+		for j, o := range groups {
+			if j != i {
+				for _, sample := range o {
+					for k := range sample.cache {
+						others[k] = true
+					}
+				}
+			}
+		}
+		if !hasOwnKey(g, others) {
+			return nil, nil
+		}
+	}
+	return values, groups
+}
+
+// 是否有所有样本都有，并且不在others中的属性
+func hasOwnKey(samples []*Node, others map[string]bool) bool {
+	for k := range samples[0].cache {
+		if others[k] {
+			continue
+		}
+		all := true
+		for _, sample := range samples[1:] {
+			if _, ok := sample.cache[k]; !ok {
+				all = false
+				break
+			}
+		}
+		if all {
+			return true
+		}
+	}
+	return false
+}
+
+// 合并多态类型，每种类型作为一个子节点，子节点的key由属性的key和区分字段的值组成，例如 events_click
+func walkPolymorphic(nodes []*Node, key string, values []string, groups [][]*Node, count int, config *Config) *Node {
+	poly := mergeNode(nodes)
+	poly.optional = len(nodes) < count || hasNil(nodes)
+	poly.g = newGroup(GroupV, groupDepth(poly.g))
+	poly.t = TypeAny
+	poly.discriminator = key
+	poly.values = values
+	poly.childrenMerge = &[][]*Node{}
+	for i, g := range groups {
+		variant := mergeNode(g)
+		variant.k = poly.k + "_" + values[i]
+		variant.t = variant.k
+		variant.g = GroupO
+		mergeArrayNode(variant, config)
+		addChildren(poly, variant)
+	}
+	return poly
+}
+
+// 是否是多态类型的分支
+func isPolymorphicVariant(node *Node) bool {
+	return node.parent != nil && node.parent.discriminator != ""
+}
+
+// 收集所有的多态类型，合并掉的结构体除外
+func collectPolymorphic(node *Node, polys *[]*Node) {
+	if node.ref != nil {
+		return
+	}
+	if node.discriminator != "" {
+		*polys = append(*polys, node)
+	}
+	for _, n := range *node.children {
+		collectPolymorphic(n, polys)
+	}
+}
+
+// 生成多态类型的声明：包装类型、接口、接口方法和根据区分字段解析的UnmarshalJSON
+// 嵌套结构体时，同时生成每种类型的结构体
+func polymorphicDecls(polys []*Node, config *Config) []string {
+	var decls []string
+	for _, p := range polys {
+		decls = append(decls, writePolymorphicDecl(p, config))
+	}
+	return decls
+}
+
+func writePolymorphicDecl(node *Node, config *Config) string {
+	var buff bytes.Buffer
+	var names []string
+	for _, v := range *node.children {
+		names = append(names, refNode(v).name)
+		if config.NestFlag {
+			buff.WriteString(fmt.Sprintf("type %s %s\n\n", v.name, recursionWrite(v, config)))
+		}
+	}
+	name := node.name
+	variant := name + "Variant"
+	buff.WriteString(fmt.Sprintf("// %s 根据%s的值解析为不同的类型\ntype %s struct {\nValue %s\n}", name, node.discriminator, name, variant))
+	buff.WriteString(fmt.Sprintf("\n\n// %s 是 %s 中的一种\ntype %s interface {\nis%s()\n}", variant, strings.Join(names, " "), variant, variant))
+	for _, n := range names {
+		buff.WriteString(fmt.Sprintf("\n\nfunc (%s) is%s() {}", n, variant))
+	}
+	// 先解析区分字段，再解析为对应的类型
+	buff.WriteString(fmt.Sprintf("\n\nfunc (e *%s) UnmarshalJSON(data []byte) error {\nif string(data) == \"null\" {\nreturn nil\n}\nvar v struct {\nDiscriminator string %s\n}\nif err := json.Unmarshal(data, &v); err != nil {\nreturn err\n}\nswitch v.Discriminator {\n", name, formatTag(node.discriminator, false, nil, []string{DefaultTag})))
+	for i, n := range names {
+		buff.WriteString(fmt.Sprintf("case %s:\nvar value %s\nerr := json.Unmarshal(data, &value)\ne.Value = value\nreturn err\n", strconv.Quote(node.values[i]), n))
+	}
+	format := fmt.Sprintf("%s: unknown %s %%q", name, strings.ReplaceAll(node.discriminator, "%", "%%"))
+	buff.WriteString(fmt.Sprintf("}\nreturn fmt.Errorf(%s, v.Discriminator)\n}", strconv.Quote(format)))
+	buff.WriteString(fmt.Sprintf("\n\nfunc (e %s) MarshalJSON() ([]byte, error) {\nreturn json.Marshal(e.Value)\n}", name))
+	return buff.String()
+}
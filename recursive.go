@@ -0,0 +1,150 @@
+package core
+
+import "fmt"
+
+// 识别树形结构，例如菜单的children，子对象和父对象的属性基本相同，并且子对象中也有相同的属性
+// 把所有层级的属性合并到父对象，返回自引用的属性
+func mergeRecursive(parent *Node, config *Config) map[string]bool {
+	if !isObject(parent.g) || parent.virtual {
+		return nil
+	}
+	keys := make(map[string]bool)
+	for _, nodes := range *parent.childrenMerge {
+		if isRecursive(parent, nodes, config) {
+			keys[nodes[0].k] = true
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	parent.recursive = true
+	// 所有层级的子对象
+	var levels []*Node
+	var queue []*Node
+	for _, nodes := range *parent.childrenMerge {
+		if keys[nodes[0].k] {
+			queue = append(queue, nodes...)
+		}
+	}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		levels = append(levels, node)
+		for _, nodes := range *node.childrenMerge {
+			if keys[nodes[0].k] {
+				queue = append(queue, nodes...)
+			}
+		}
+	}
+	childrenMerge := *parent.childrenMerge
+	parent.childrenMerge = &[][]*Node{}
+	parent.cache = make(map[string]int)
+	addRecursiveMerge(parent, childrenMerge, keys)
+	for _, node := range levels {
+		parent.count += node.count
+		addRecursiveMerge(parent, *node.childrenMerge, keys)
+	}
+	return keys
+}
+
+// 添加子节点，自引用的属性不需要子节点
+func addRecursiveMerge(parent *Node, childrenMerge [][]*Node, keys map[string]bool) {
+	for _, nodes := range childrenMerge {
+		for _, node := range nodes {
+			if keys[node.k] {
+				n := *node
+				n.childrenMerge = &[][]*Node{}
+				n.cache = make(map[string]int)
+				n.samples = nil
+				node = &n
+			}
+			addChildrenMerge(parent, node)
+		}
+	}
+}
+
+// 属性的值是对象或者对象数组，子对象中也有这个属性，并且除这个属性外，子对象和父对象至少一半的属性相同
+// 父对象是包装结构并且这个属性是数据时不是树形结构，例如 {"code": 0, "data": {"code": 1, "data": {}}}
+func isRecursive(parent *Node, nodes []*Node, config *Config) bool {
+	key := nodes[0].k
+	object := false
+	for _, node := range nodes {
+		if groupBase(node.g) == GroupO {
+			object = true
+		} else if groupBase(node.g) != "" && !(node.g == GroupV && node.t == TypeNil) {
+			// 空数组和null除外
+			return false
+		}
+	}
+	if !object {
+		return false
+	}
+	var parentKeys []string
+	for k := range parent.cache {
+		parentKeys = append(parentKeys, k)
+	}
+	for _, e := range configEnvelopes(config) {
+		if e.Payload == key && matchEnvelopeKeys(parentKeys, e) {
+			return false
+		}
+	}
+	nested := false
+	keys := make(map[string]bool)
+	for _, node := range nodes {
+		for k := range node.cache {
+			if k == key {
+				nested = true
+			} else {
+				keys[k] = true
+			}
+		}
+	}
+	if !nested || len(keys) == 0 || len(parent.cache) < 2 {
+		return false
+	}
+	same := 0
+	for k := range keys {
+		if _, ok := parent.cache[k]; ok {
+			same++
+		}
+	}
+	return same*2 >= len(keys)+len(parent.cache)-1-same
+}
+
+// 是否是引用父结构体的属性
+func isSelfRef(node *Node) bool {
+	return node.ref != nil && node.ref == node.parent
+}
+
+// 收集所有的树形结构体
+func collectRecursive(node *Node, recursives *[]*Node) {
+	if node.ref != nil {
+		return
+	}
+	if node.recursive {
+		*recursives = append(*recursives, node)
+	}
+	for _, n := range *node.children {
+		collectRecursive(n, recursives)
+	}
+}
+
+// 嵌套结构体的类型，树形结构体需要引用自己，使用结构体名称
+func nestType(node *Node, config *Config) string {
+	if node.recursive {
+		return node.name
+	}
+	return recursionWrite(node, config)
+}
+
+// 嵌套结构体时，生成树形结构体的声明，根结构体除外
+func recursiveDecls(recursives []*Node, root *Node, config *Config) []string {
+	var decls []string
+	for _, r := range recursives {
+		if r == root && r.g == GroupO || isPolymorphicVariant(r) {
+			continue
+		}
+		decls = append(decls, fmt.Sprintf("type %s %s", r.name, recursionWrite(r, config)))
+	}
+	return decls
+}
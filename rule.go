@@ -0,0 +1,49 @@
+package core
+
+import (
+	"encoding/json"
+)
+
+// Rule 根据json路径修改属性，路径支持通配符，例如 $.data.items[*].price $..id
+type Rule struct {
+	// json路径
+	Path string `json:"path"`
+	// 属性的类型，原样输出，例如 decimal.Decimal
+	Type string `json:"type,omitempty"`
+	// 属性名
+	Name string `json:"name,omitempty"`
+	// tag的选项，tag名；选项，例如 json:omitempty
+	TagOptions map[string]string `json:"tagOptions,omitempty"`
+	// 忽略这个属性
+	Skip bool `json:"skip,omitempty"`
+}
+
+// ParseRules 解析规则文件，内容是规则的json数组，例如 [{"path": "$.data.items[*].price", "type": "float64"}]
+func ParseRules(str string) ([]Rule, error) {
+	var rules []Rule
+	if err := json.Unmarshal([]byte(str), &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// 使用匹配路径的规则修改注解，规则优先于注释中的注解，多个规则匹配时后面的优先
+func applyRules(a *annotation, path string, config *Config) {
+	for _, rule := range config.Rules {
+		if !matchPath(rule.Path, path) {
+			continue
+		}
+		if rule.Type != "" {
+			a.t = rule.Type
+		}
+		if rule.Name != "" {
+			a.name = rule.Name
+		}
+		if len(rule.TagOptions) > 0 {
+			a.tagOptions = rule.TagOptions
+		}
+		if rule.Skip {
+			a.skip = true
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package core
+
+import (
+	"bytes"
+	"json-to-go/jsonparser"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// 日期格式
+	dateLayout = "2006-01-02"
+	// 秒级时间戳的范围，2001-09-09 ~ 2286-11-20
+	minUnix = 1e9
+	maxUnix = 1e10
+	// 毫秒级时间戳的范围
+	minUnixMilli = 1e12
+	maxUnixMilli = 1e13
+)
+
+// 属性名包含这些单词时，才会把整数识别为时间戳
+var timeKeyWords = []string{"time", "date", "at", "ts", "timestamp", "expire", "expires"}
+
+// 时间戳包装类型的声明，按照顺序输出
+var timeDecls = []struct {
+	t    string
+	decl string
+}{
+	{TypeDate, `// Date 日期，格式为 2006-01-02
+type Date struct {
+	time.Time
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	t, err := time.Parse(` + "`\"2006-01-02\"`" + `, string(data))
+	if err != nil {
+		return err
+	}
+	d.Time = t
+	return nil
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return []byte(d.Format(` + "`\"2006-01-02\"`" + `)), nil
+}`},
+	{TypeUnixTime, `// UnixTime 秒级时间戳
+type UnixTime struct {
+	time.Time
+}
+
+func (t *UnixTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	sec, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return err
+	}
+	t.Time = time.Unix(sec, 0)
+	return nil
+}
+
+func (t UnixTime) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+}`},
+	{TypeUnixMilliTime, `// UnixMilliTime 毫秒级时间戳
+type UnixMilliTime struct {
+	time.Time
+}
+
+func (t *UnixMilliTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	msec, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return err
+	}
+	t.Time = time.UnixMilli(msec)
+	return nil
+}
+
+func (t UnixMilliTime) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(t.UnixMilli(), 10)), nil
+}`},
+}
+
+// 推断时间类型，不是时间时返回原来的类型t
+func getTimeType(key string, value []byte, dataType jsonparser.ValueType, t string) string {
+	switch dataType {
+	case jsonparser.String:
+		v := string(value)
+		if _, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return TypeTime
+		}
+		if _, err := time.Parse(dateLayout, v); err == nil {
+			return TypeDate
+		}
+	case jsonparser.Number:
+		if !isTimeKey(key) {
+			return t
+		}
+		i, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return t
+		}
+		if i >= minUnix && i < maxUnix {
+			return TypeUnixTime
+		}
+		if i >= minUnixMilli && i < maxUnixMilli {
+			return TypeUnixMilliTime
+		}
+	}
+	return t
+}
+
+// 属性名是否和时间相关，例如 create_time createdAt ts
+func isTimeKey(key string) bool {
+	for _, word := range strings.Split(convertToUnderline(key), "_") {
+		word = strings.ToLower(word)
+		for _, w := range timeKeyWords {
+			if word == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// 输出用到的时间戳包装类型
+func writeTimeTypes(buff *bytes.Buffer, used map[string]bool) {
+	for _, d := range timeDecls {
+		if used[d.t] {
+			buff.WriteString("\n\n")
+			buff.WriteString(d.decl)
+		}
+	}
+}
+
+// 收集用到的时间戳包装类型，需要在枚举和联合类型修改t之前调用
+// 只有值类型的属性使用包装类型，对象的t是key，注解指定的类型原样输出
+func collectTimeTypes(node *Node, used map[string]bool) {
+	if groupBase(node.g) == GroupV && node.hint.t == "" && !node.enum && !node.union && node.discriminator == "" {
+		used[node.t] = true
+	}
+	for _, n := range *node.children {
+		collectTimeTypes(n, used)
+	}
+}
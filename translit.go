@@ -0,0 +1,208 @@
+package core
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 日文假名转罗马字（平文式），片假名转换为平假名后查表
+var kanaTable = buildRuneTable(map[string]string{
+	"a": "あ", "i": "い", "u": "う", "e": "え", "o": "お",
+	"ka": "か", "ki": "き", "ku": "く", "ke": "け", "ko": "こ",
+	"sa": "さ", "shi": "し", "su": "す", "se": "せ", "so": "そ",
+	"ta": "た", "chi": "ち", "tsu": "つ", "te": "て", "to": "と",
+	"na": "な", "ni": "に", "nu": "ぬ", "ne": "ね", "no": "の",
+	"ha": "は", "hi": "ひ", "fu": "ふ", "he": "へ", "ho": "ほ",
+	"ma": "ま", "mi": "み", "mu": "む", "me": "め", "mo": "も",
+	"ya": "や", "yu": "ゆ", "yo": "よ",
+	"ra": "ら", "ri": "り", "ru": "る", "re": "れ", "ro": "ろ",
+	"wa": "わ", "wi": "ゐ", "we": "ゑ", "wo": "を", "n": "ん",
+	"ga": "が", "gi": "ぎ", "gu": "ぐ", "ge": "げ", "go": "ご",
+	"za": "ざ", "ji": "じぢ", "zu": "ずづ", "ze": "ぜ", "zo": "ぞ",
+	"da": "だ", "de": "で", "do": "ど",
+	"ba": "ば", "bi": "び", "bu": "ぶ", "be": "べ", "bo": "ぼ",
+	"pa": "ぱ", "pi": "ぴ", "pu": "ぷ", "pe": "ぺ", "po": "ぽ",
+	"vu": "ゔ",
+})
+
+// 小写的假名，和前一个假名组成拗音
+var smallKanaTable = buildRuneTable(map[string]string{
+	"a": "ぁ", "i": "ぃ", "u": "ぅ", "e": "ぇ", "o": "ぉ",
+	"ya": "ゃ", "yu": "ゅ", "yo": "ょ", "wa": "ゎ",
+})
+
+// 韩文转写（文化观光部2000年式），按初声、中声、终声拼接，不处理音变
+var hangulInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+var hangulMedials = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+var hangulFinals = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+
+// 西里尔字母，包括俄文、乌克兰文、白俄罗斯文
+var cyrillicTable = buildRuneTable(map[string]string{
+	"a": "а", "b": "б", "v": "в", "g": "гґ", "d": "д", "e": "еэ", "yo": "ё", "zh": "ж", "z": "з",
+	"i": "иі", "y": "йы", "k": "к", "l": "л", "m": "м", "n": "н", "o": "о", "p": "п", "r": "р",
+	"s": "с", "t": "т", "u": "уў", "f": "ф", "kh": "х", "ts": "ц", "ch": "ч", "sh": "ш", "shch": "щ",
+	"": "ъь", "yu": "ю", "ya": "я", "yi": "ї", "ye": "є",
+})
+
+// 希腊字母
+var greekTable = buildRuneTable(map[string]string{
+	"a": "αά", "v": "β", "g": "γ", "d": "δ", "e": "εέ", "z": "ζ", "i": "ηήιίϊΐ", "th": "θ",
+	"k": "κ", "l": "λ", "m": "μ", "n": "ν", "x": "ξ", "o": "οόωώ", "p": "π", "r": "ρ",
+	"s": "σς", "t": "τ", "y": "υύϋΰ", "f": "φ", "ch": "χ", "ps": "ψ",
+})
+
+// 带变音符号的拉丁字母去掉变音符号
+var latinTable = buildRuneTable(map[string]string{
+	"a": "àáâãäåāăąǎ", "c": "çćĉċč", "d": "ďđð", "e": "èéêëēĕėęě", "g": "ĝğġģ", "h": "ĥħ",
+	"i": "ìíîïĩīĭįıǐ", "j": "ĵ", "k": "ķ", "l": "ĺļľŀł", "n": "ñńņňŉ", "o": "òóôõöøōŏőǒ",
+	"r": "ŕŗř", "s": "śŝşš", "t": "ţťŧ", "u": "ùúûüũūŭůűųǔǖǘǚǜ", "w": "ŵ", "y": "ýÿŷ", "z": "źżž",
+	"ss": "ß", "ae": "æ", "oe": "œ", "th": "þ",
+})
+
+func buildRuneTable(m map[string]string) map[rune]string {
+	table := make(map[rune]string)
+	for k, v := range m {
+		for _, r := range v {
+			table[r] = k
+		}
+	}
+	return table
+}
+
+// 是否可以转写为拉丁字母，中文使用拼音不在这里处理
+func isTransliterable(r rune) bool {
+	if isKana(r) || isHangul(r) {
+		return true
+	}
+	r = unicode.ToLower(r)
+	if _, ok := cyrillicTable[r]; ok {
+		return true
+	}
+	if _, ok := greekTable[r]; ok {
+		return true
+	}
+	_, ok := latinTable[r]
+	return ok
+}
+
+// 平假名和片假名，包括长音符号
+func isKana(r rune) bool {
+	return r >= 0x3041 && r <= 0x3096 || r >= 0x30A1 && r <= 0x30F6 || r == 'ー'
+}
+
+func isHangul(r rune) bool {
+	return r >= 0xAC00 && r <= 0xD7A3
+}
+
+// 连续的可以转写的文字的结束位置
+func transliterateEnd(runes []rune, start int) int {
+	end := start
+	for end < len(runes) && isTransliterable(runes[end]) {
+		end++
+	}
+	return end
+}
+
+// Transliterate 日文假名、韩文、西里尔字母、希腊字母和带变音符号的拉丁字母转写为拉丁字母
+// 大写字母保持大写，全大写的单词转写后也全大写，不支持的文字会被忽略
+func Transliterate(str string) string {
+	var buffer strings.Builder
+	runes := []rune(str)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if isKana(r) {
+			// 连续的假名一起转换，处理拗音和促音
+			j := i
+			for j < len(runes) && isKana(runes[j]) {
+				j++
+			}
+			buffer.WriteString(kanaToRomaji(runes[i:j]))
+			i = j - 1
+			continue
+		}
+		if isHangul(r) {
+			index := int(r - 0xAC00)
+			buffer.WriteString(hangulInitials[index/588])
+			buffer.WriteString(hangulMedials[index%588/28])
+			buffer.WriteString(hangulFinals[index%28])
+			continue
+		}
+		lower := unicode.ToLower(r)
+		s, ok := cyrillicTable[lower]
+		if !ok {
+			s, ok = greekTable[lower]
+		}
+		if !ok {
+			s, ok = latinTable[lower]
+		}
+		if !ok {
+			continue
+		}
+		if lower != r {
+			if isUpperAt(runes, i-1) || isUpperAt(runes, i+1) {
+				// 全大写的单词，多个字母的转写也全部大写，例如 ЩИ 转换为 SHCHI
+				s = strings.ToUpper(s)
+			} else {
+				s = upperFirst(s)
+			}
+		}
+		buffer.WriteString(s)
+	}
+	return buffer.String()
+}
+
+func isUpperAt(runes []rune, i int) bool {
+	return i >= 0 && i < len(runes) && unicode.IsUpper(runes[i])
+}
+
+func kanaToRomaji(runes []rune) string {
+	var result []string
+	// 促音，下一个假名的辅音重复
+	sokuon := false
+	for _, r := range runes {
+		if r >= 0x30A1 && r <= 0x30F6 {
+			// 片假名转换为平假名
+			r -= 0x60
+		}
+		switch {
+		case r == 'っ':
+			sokuon = true
+			continue
+		case r == 'ー':
+			// 长音忽略
+			continue
+		}
+		if s, ok := smallKanaTable[r]; ok && len(result) > 0 {
+			last := result[len(result)-1]
+			if strings.HasPrefix(s, "y") && strings.HasSuffix(last, "i") && len(last) > 1 {
+				// 拗音，例如 きゃ kya しゃ sha ちゃ cha じゃ ja
+				last = strings.TrimSuffix(last, "i")
+				if last == "sh" || last == "ch" || last == "j" {
+					s = s[1:]
+				}
+			} else {
+				// 外来语的小写元音，例如 ファ fa ティ ti ウィ wi
+				if last == "u" {
+					last = "w"
+				}
+				last = strings.TrimRight(last, "aiueo")
+				s = s[len(s)-1:]
+			}
+			result[len(result)-1] = last + s
+			continue
+		}
+		s, ok := kanaTable[r]
+		if !ok {
+			s, ok = smallKanaTable[r]
+		}
+		if !ok {
+			continue
+		}
+		if sokuon && s != "" && strings.IndexByte("aiueon", s[0]) < 0 {
+			s = s[:1] + s
+		}
+		sokuon = false
+		result = append(result, s)
+	}
+	return strings.Join(result, "")
+}
@@ -0,0 +1,117 @@
+package core
+
+import "strconv"
+
+// 结构体名称的保留字，根结构体和用到的时间戳包装类型
+func reservedNames(times map[string]bool, config *Config) map[string]bool {
+	reserved := map[string]bool{
+		formatName(rootName(config), config): true,
+	}
+	for _, d := range timeDecls {
+		if times[d.t] {
+			reserved[d.t] = true
+		}
+	}
+	return reserved
+}
+
+// 分配结构体名称，all[0]是根结构体，名称已经确定
+// 结构体名称在单独的命名空间内，重名时使用父结构体的key作为前缀，例如 OrderItems CartItems，仍然重名时末尾加数字
+func assignTypeNames(all []*Node, reserved map[string]bool, config *Config) {
+	if len(all) == 0 {
+		return
+	}
+	nodes := make([]*Node, 0)
+	for _, a := range all[1:] {
+		// 包装结构使用泛型类型，不需要名称
+		if a.ref == nil && a.envelope == nil {
+			nodes = append(nodes, a)
+		}
+	}
+	// 使用几级父结构体作为前缀
+	level := make(map[*Node]int)
+	for {
+		names := make(map[string][]*Node)
+		for _, a := range nodes {
+			name := formatTypeName(qualifiedKey(a, level[a], config), config)
+			names[name] = append(names[name], a)
+		}
+		changed := false
+		for name, array := range names {
+			if len(array) < 2 && !reserved[name] {
+				continue
+			}
+			for _, a := range array {
+				if qualifiedKey(a, level[a]+1, config) != "" {
+					level[a]++
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	used := make(map[string]bool)
+	for name := range reserved {
+		used[name] = true
+	}
+	for _, a := range nodes {
+		name := formatTypeName(qualifiedKey(a, level[a], config), config)
+		if used[name] {
+			// 重名了，末尾加数字
+			for i := 1; ; i++ {
+				if !used[name+strconv.Itoa(i)] {
+					name = name + strconv.Itoa(i)
+					break
+				}
+			}
+		}
+		used[name] = true
+		a.name = name
+	}
+}
+
+// 使用level级父结构体的key作为前缀，父结构体不够时返回空
+func qualifiedKey(node *Node, level int, config *Config) string {
+	key := node.k
+	p := node.parent
+	for i := 0; i < level; p = p.parent {
+		if p == nil {
+			return ""
+		}
+		// map的值和map使用相同的key，跳过
+		if !isObject(p.g) {
+			continue
+		}
+		if p.parent == nil {
+			key = rootName(config) + "_" + key
+		} else {
+			key = p.k + "_" + key
+		}
+		i++
+	}
+	return key
+}
+
+// 分配结构体和枚举的名称，枚举的类型使用枚举的名称
+func assignEnumNames(all []*Node, enums []*Node, reserved map[string]bool, config *Config) {
+	assignTypeNames(all, reserved, config)
+	for _, e := range enums {
+		e.t = e.name
+	}
+}
+
+// 已经使用的类型名称
+func usedNames(all []*Node, reserved map[string]bool) map[string]bool {
+	used := make(map[string]bool)
+	for name := range reserved {
+		used[name] = true
+	}
+	for _, a := range all {
+		if a.ref == nil && a.name != "" {
+			used[a.name] = true
+		}
+	}
+	return used
+}
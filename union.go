@@ -0,0 +1,265 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 联合类型的分支，按照json的类型区分
+const (
+	unionString = iota
+	unionNumber
+	unionBool
+	unionObject
+	unionArray
+)
+
+// 按照json的类型对样本分组，至少有两种类型时生成联合类型，null不作为分支
+// 存在any、map或者注解指定了类型时，不生成联合类型
+func unionVariants(nodes []*Node) [][]*Node {
+	variants := make([][]*Node, unionArray+1)
+	for _, node := range nodes {
+		if node.hint.t != "" || groupBase(node.g) == GroupM {
+			return nil
+		}
+		kind := sampleKind(node)
+		if kind < 0 {
+			if node.g == GroupV && node.t == TypeNil {
+				continue
+			}
+			return nil
+		}
+		variants[kind] = append(variants[kind], node)
+	}
+	var result [][]*Node
+	for _, v := range variants {
+		if len(v) > 0 {
+			result = append(result, v)
+		}
+	}
+	if len(result) < 2 {
+		return nil
+	}
+	return result
+}
+
+// 样本的json类型
+func sampleKind(node *Node) int {
+	if groupDepth(node.g) > 0 {
+		return unionArray
+	}
+	if isObject(node.g) {
+		return unionObject
+	}
+	return valueKind(node.t)
+}
+
+// 基础类型对应的json类型，不是基础类型时返回-1
+func valueKind(t string) int {
+	switch t {
+	case TypeString, TypeTime, TypeDate:
+		return unionString
+	case TypeInt, TypeInt64, TypeUint64, TypeNumber, TypeBigInt, TypeNegInt, TypeNegInt64, TypeFloat64, TypeUnixTime, TypeUnixMilliTime:
+		return unionNumber
+	case TypeBool:
+		return unionBool
+	}
+	return -1
+}
+
+// 合并联合类型，每个分支作为一个子节点
+// 同时存在对象和对象数组时，使用相同的结构体，例如 ItemOrItems
+func walkUnion(nodes []*Node, variants [][]*Node, count int, config *Config) *Node {
+	union := mergeNode(nodes)
+	union.optional = len(nodes) < count || hasNil(nodes)
+	union.g = GroupV
+	union.t = TypeAny
+	union.union = true
+	union.childrenMerge = &[][]*Node{}
+	last := variants[len(variants)-1]
+	share := sampleKind(last[0]) == unionArray && isObjectArray(last)
+	var object *Node
+	for _, v := range variants {
+		switch {
+		case share && sampleKind(v[0]) == unionObject:
+			object = mergeNode(append(v, last...))
+			object.g = GroupO
+			object.t = object.k
+			mergeArrayNode(object, config)
+			addChildren(union, object)
+		case share && object != nil:
+			// 对象数组引用对象的结构体
+			array := mergeNode(v)
+			array.childrenMerge = &[][]*Node{}
+			array.ref = object
+			addChildren(union, array)
+		default:
+			addChildren(union, walkNode(v, 0, config))
+		}
+	}
+	return union
+}
+
+// 是否都是一维的对象数组或者空数组
+func isObjectArray(nodes []*Node) bool {
+	for _, node := range nodes {
+		if node.g != newGroup(GroupO, 1) && node.g != groupArray {
+			return false
+		}
+	}
+	return true
+}
+
+// 收集所有的联合类型，子节点在前面，合并掉的结构体除外
+func collectUnions(node *Node, unions *[]*Node) {
+	if node.ref != nil {
+		return
+	}
+	for _, n := range *node.children {
+		collectUnions(n, unions)
+	}
+	if node.union {
+		*unions = append(*unions, node)
+	}
+}
+
+// 联合类型的分支
+type unionVariant struct {
+	// 属性名
+	name string
+	// 属性类型
+	t string
+	// json的类型
+	kind int
+}
+
+// 生成联合类型的声明，联合类型的名称由分支组成，例如 StringOrInt ItemOrItems
+// 声明完全相同的联合类型只生成一个，used是已经使用的类型名称
+func unionDecls(unions []*Node, used map[string]bool, config *Config) []string {
+	var decls []string
+	declMap := make(map[string]string)
+	for _, u := range unions {
+		var variants []unionVariant
+		var names []string
+		nameMap := make(map[string]string)
+		nameCount := make(map[string]int)
+		for _, v := range *u.children {
+			name := formatKey(nameMap, nameCount, variantName(v), config)
+			names = append(names, name)
+			variants = append(variants, unionVariant{name: name, t: variantType(v, config), kind: variantKind(v)})
+		}
+		base := strings.Join(names, "Or")
+		name := base
+		for i := 1; ; i++ {
+			decl := writeUnionDecl(name, variants)
+			if e, ok := declMap[name]; ok && e == decl {
+				// 相同的联合类型
+				break
+			}
+			if !used[name] {
+				used[name] = true
+				declMap[name] = decl
+				decls = append(decls, decl)
+				break
+			}
+			name = base + strconv.Itoa(i)
+		}
+		u.t = name
+	}
+	return decls
+}
+
+func variantKind(node *Node) int {
+	if groupDepth(node.g) > 0 {
+		return unionArray
+	}
+	if isObject(node.g) || node.discriminator != "" {
+		return unionObject
+	}
+	if node.enum {
+		// 枚举的类型已经替换为枚举的名称
+		return unionString
+	}
+	return valueKind(node.t)
+}
+
+// 分支的名称，数组使用元素的名称加s，例如 Item Items
+func variantName(node *Node) string {
+	name := "object"
+	if isObject(node.g) {
+		if ref := refNode(node); ref.name != "" {
+			name = ref.name
+		}
+	} else if node.t == TypeAny || node.t == TypeNil {
+		name = "any"
+	} else {
+		// 去掉包名和指针，例如 time.Time *big.Int
+		name = strings.TrimPrefix(node.t[strings.LastIndex(node.t, ".")+1:], "*")
+	}
+	return name + strings.Repeat("s", groupDepth(node.g))
+}
+
+// 分支的类型，基础类型和对象使用指针，数组使用切片
+func variantType(node *Node, config *Config) string {
+	typeName := node.t
+	if isObject(node.g) {
+		if config.NestFlag {
+			typeName = nestType(refNode(node), config)
+		} else {
+			typeName = refNode(node).name
+		}
+	}
+	result := formatType(typeName, node.t, node.g, false)
+	if groupDepth(node.g) == 0 {
+		result = "*" + result
+	}
+	return result
+}
+
+func writeUnionDecl(name string, variants []unionVariant) string {
+	var buff bytes.Buffer
+	buff.WriteString(fmt.Sprintf("// %s 属性在不同的样本中类型不同，按照json的类型解析\ntype %s struct {\n", name, name))
+	for _, v := range variants {
+		buff.WriteString(fmt.Sprintf("%s %s\n", v.name, v.t))
+	}
+	buff.WriteString("}")
+	// 访问方法
+	for _, v := range variants {
+		t := strings.TrimPrefix(v.t, "*")
+		if strings.HasPrefix(v.t, "*") {
+			buff.WriteString(fmt.Sprintf("\n\nfunc (u %s) As%s() (%s, bool) {\nif u.%s == nil {\nvar v %s\nreturn v, false\n}\nreturn *u.%s, true\n}", name, v.name, t, v.name, t, v.name))
+		} else {
+			buff.WriteString(fmt.Sprintf("\n\nfunc (u %s) As%s() (%s, bool) {\nreturn u.%s, u.%s != nil\n}", name, v.name, t, v.name, v.name))
+		}
+	}
+	// 根据json的第一个字符判断类型
+	buff.WriteString(fmt.Sprintf("\n\nfunc (u *%s) UnmarshalJSON(data []byte) error {\nif string(data) == \"null\" {\nreturn nil\n}\nswitch data[0] {\n", name))
+	for _, v := range variants {
+		switch v.kind {
+		case unionString:
+			buff.WriteString("case '\"':\n")
+		case unionNumber:
+			buff.WriteString("case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':\n")
+		case unionBool:
+			buff.WriteString("case 't', 'f':\n")
+		case unionObject:
+			buff.WriteString("case '{':\n")
+		case unionArray:
+			buff.WriteString("case '[':\n")
+		}
+		if strings.HasPrefix(v.t, "*") {
+			buff.WriteString(fmt.Sprintf("u.%s = new(%s)\nreturn json.Unmarshal(data, u.%s)\n", v.name, strings.TrimPrefix(v.t, "*"), v.name))
+		} else {
+			buff.WriteString(fmt.Sprintf("return json.Unmarshal(data, &u.%s)\n", v.name))
+		}
+	}
+	buff.WriteString(fmt.Sprintf("}\nreturn fmt.Errorf(\"%s: unexpected json %%s\", data)\n}", name))
+	buff.WriteString(fmt.Sprintf("\n\nfunc (u %s) MarshalJSON() ([]byte, error) {\nswitch {\n", name))
+	for _, v := range variants {
+		buff.WriteString(fmt.Sprintf("case u.%s != nil:\nreturn json.Marshal(u.%s)\n", v.name, v.name))
+	}
+	buff.WriteString("}\nreturn []byte(\"null\"), nil\n}")
+	return buff.String()
+}